@@ -0,0 +1,181 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+	"unicode/utf8"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// MinPasswordLength パスワードの最小文字数
+	MinPasswordLength = 8
+	// maxPasswordBytes bcrypt が扱えるパスワードの最大バイト数
+	maxPasswordBytes = 72
+	// MaxDisplayNameLength 表示名の最大文字数
+	MaxDisplayNameLength = 50
+)
+
+type IAuthUseCase interface {
+	SignUp(ctx context.Context, req *dto.SignUpRequest) (*model.AuthResult, error)
+	Login(ctx context.Context, req *dto.LoginRequest) (*model.AuthResult, error)
+	Refresh(ctx context.Context, refreshToken string) (*model.AuthResult, error)
+	Authenticate(ctx context.Context, accessToken string) (*authctx.Principal, error)
+	GetCurrentUser(ctx context.Context) (*model.User, error)
+}
+
+type AuthUseCase struct {
+	userRepo     repository.IUserRepository
+	tokenService service.ITokenService
+	bcryptCost   int
+	now          func() time.Time
+	newID        func() string
+}
+
+func NewAuthUseCase(userRepo repository.IUserRepository, tokenService service.ITokenService) IAuthUseCase {
+	return &AuthUseCase{
+		userRepo:     userRepo,
+		tokenService: tokenService,
+		bcryptCost:   bcrypt.DefaultCost,
+		now:          time.Now,
+		newID:        func() string { return "user_" + idgen.New() },
+	}
+}
+
+func (uc *AuthUseCase) SignUp(ctx context.Context, req *dto.SignUpRequest) (*model.AuthResult, error) {
+	if err := validateSignUp(req); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), uc.bcryptCost)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to hash password: %w", err))
+	}
+
+	user := model.NewUser(uc.newID(), req.Email, req.DisplayName, string(hash), uc.now())
+	if err := uc.userRepo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("user signed up", "userId", user.ID)
+
+	return uc.issue(user)
+}
+
+func (uc *AuthUseCase) Login(ctx context.Context, req *dto.LoginRequest) (*model.AuthResult, error) {
+	if req.Email == "" || req.Password == "" {
+		return nil, errs.NewBadRequestError("email and password are required")
+	}
+
+	user, err := uc.userRepo.GetUserByEmailToData(ctx, req.Email)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			// 登録の有無で応答時間が変わらないよう、存在しない場合もハッシュの比較を行う
+			bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+			logging.FromContext(ctx).Warn("login failed", "reason", "unknown email")
+			return nil, errs.NewUnauthorizedError("invalid email or password")
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		logging.FromContext(ctx).Warn("login failed", "reason", "wrong password", "userId", user.ID)
+		return nil, errs.NewUnauthorizedError("invalid email or password")
+	}
+
+	return uc.issue(user)
+}
+
+func (uc *AuthUseCase) Refresh(ctx context.Context, refreshToken string) (*model.AuthResult, error) {
+	if refreshToken == "" {
+		return nil, errs.NewBadRequestError("refreshToken is required")
+	}
+
+	claims, err := uc.tokenService.ParseToken(refreshToken, model.TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	// 削除済みのユーザーには再発行しない
+	user, err := uc.userRepo.GetUserByIDToData(ctx, claims.UserID)
+	if err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.NewUnauthorizedError("user no longer exists")
+		}
+		return nil, err
+	}
+
+	return uc.issue(user)
+}
+
+func (uc *AuthUseCase) Authenticate(ctx context.Context, accessToken string) (*authctx.Principal, error) {
+	claims, err := uc.tokenService.ParseToken(accessToken, model.TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	role := claims.Role
+	if role == "" {
+		role = model.RoleLearner
+	}
+
+	return &authctx.Principal{
+		UserID: claims.UserID,
+		Email:  claims.Email,
+		Role:   string(role),
+	}, nil
+}
+
+func (uc *AuthUseCase) GetCurrentUser(ctx context.Context) (*model.User, error) {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errs.NewUnauthorizedError("authentication is required")
+	}
+
+	return uc.userRepo.GetUserByIDToData(ctx, principal.UserID)
+}
+
+func (uc *AuthUseCase) issue(user *model.User) (*model.AuthResult, error) {
+	tokens, err := uc.tokenService.IssueTokens(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuthResult{
+		User:   user,
+		Tokens: tokens,
+	}, nil
+}
+
+// dummyPasswordHash 存在しないユーザーのログイン時に比較に使うハッシュ（bcrypt.DefaultCost で生成）
+var dummyPasswordHash = []byte("$2a$10$AWxj6hy4WmAMwLdpYiZzkeB0ICy6grcCd8rHwRNC/WaCmN.IPs7QO")
+
+func validateSignUp(req *dto.SignUpRequest) error {
+	address, err := mail.ParseAddress(req.Email)
+	if err != nil || address.Address != req.Email {
+		return errs.NewBadRequestError("email is invalid")
+	}
+	if utf8.RuneCountInString(req.Password) < MinPasswordLength {
+		return errs.NewBadRequestError(fmt.Sprintf("password must be at least %d characters", MinPasswordLength))
+	}
+	if len(req.Password) > maxPasswordBytes {
+		return errs.NewBadRequestError(fmt.Sprintf("password must be at most %d bytes", maxPasswordBytes))
+	}
+	if utf8.RuneCountInString(req.DisplayName) > MaxDisplayNameLength {
+		return errs.NewBadRequestError(fmt.Sprintf("displayName must be at most %d characters", MaxDisplayNameLength))
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestAuthUseCase(userRepo *mock_repository.MockIUserRepository, tokenService *mock_service.MockITokenService) *AuthUseCase {
+	return &AuthUseCase{
+		userRepo:     userRepo,
+		tokenService: tokenService,
+		bcryptCost:   bcrypt.MinCost,
+		now:          func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) },
+		newID:        func() string { return "user_001" },
+	}
+}
+
+func newTestUser(t *testing.T, password string) *model.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	assert.NoError(t, err)
+	return model.NewUser("user_001", "taro@example.com", "たろう", string(hash), time.Now())
+}
+
+func TestAuthUseCase_SignUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	mockTokenService := mock_service.NewMockITokenService(ctrl)
+	usecase := newTestAuthUseCase(mockUserRepo, mockTokenService)
+
+	tests := []struct {
+		name    string
+		req     *dto.SignUpRequest
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			req:  &dto.SignUpRequest{Email: "Taro@Example.com", Password: "password123", DisplayName: "たろう"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, user *model.User) error {
+						assert.Equal(t, "taro@example.com", user.Email)
+						assert.Equal(t, "USER#user_001", user.PK)
+						assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("password123")))
+						return nil
+					}).
+					Times(1)
+				mockTokenService.EXPECT().
+					IssueTokens(gomock.Any()).
+					Return(&model.TokenPair{AccessToken: "access", RefreshToken: "refresh", ExpiresIn: 900}, nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_不正なメールアドレス",
+			req:     &dto.SignUpRequest{Email: "taro", Password: "password123"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_短いパスワード",
+			req:     &dto.SignUpRequest{Email: "taro@example.com", Password: "short"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_登録済みのメールアドレス",
+			req:  &dto.SignUpRequest{Email: "taro@example.com", Password: "password123"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					Return(errs.NewBadRequestError("email is already registered")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.SignUp(context.Background(), tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "user_001", result.User.ID)
+				assert.Equal(t, "access", result.Tokens.AccessToken)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	mockTokenService := mock_service.NewMockITokenService(ctrl)
+	usecase := newTestAuthUseCase(mockUserRepo, mockTokenService)
+	user := newTestUser(t, "password123")
+
+	tests := []struct {
+		name    string
+		req     *dto.LoginRequest
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			req:  &dto.LoginRequest{Email: "taro@example.com", Password: "password123"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByEmailToData(gomock.Any(), "taro@example.com").
+					Return(user, nil).
+					Times(1)
+				mockTokenService.EXPECT().
+					IssueTokens(user).
+					Return(&model.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系_パスワード誤り",
+			req:  &dto.LoginRequest{Email: "taro@example.com", Password: "wrong-password"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByEmailToData(gomock.Any(), "taro@example.com").
+					Return(user, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+		{
+			name: "異常系_未登録のメールアドレス",
+			req:  &dto.LoginRequest{Email: "hanako@example.com", Password: "password123"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByEmailToData(gomock.Any(), "hanako@example.com").
+					Return(nil, errs.NewNotFoundError("user not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+		{
+			name: "異常系_リポジトリエラー",
+			req:  &dto.LoginRequest{Email: "taro@example.com", Password: "password123"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByEmailToData(gomock.Any(), "taro@example.com").
+					Return(nil, errors.New("database error")).
+					Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.Login(context.Background(), tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, user, result.User)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	mockTokenService := mock_service.NewMockITokenService(ctrl)
+	usecase := newTestAuthUseCase(mockUserRepo, mockTokenService)
+	user := newTestUser(t, "password123")
+
+	tests := []struct {
+		name    string
+		token   string
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name:  "正常系",
+			token: "refresh",
+			setup: func() {
+				mockTokenService.EXPECT().
+					ParseToken("refresh", model.TokenTypeRefresh).
+					Return(&model.TokenClaims{UserID: "user_001", Type: model.TokenTypeRefresh}, nil).
+					Times(1)
+				mockUserRepo.EXPECT().
+					GetUserByIDToData(gomock.Any(), "user_001").
+					Return(user, nil).
+					Times(1)
+				mockTokenService.EXPECT().
+					IssueTokens(user).
+					Return(&model.TokenPair{AccessToken: "access2", RefreshToken: "refresh2"}, nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "異常系_不正なトークン",
+			token: "access",
+			setup: func() {
+				mockTokenService.EXPECT().
+					ParseToken("access", model.TokenTypeRefresh).
+					Return(nil, errs.NewUnauthorizedError("invalid token type")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+		{
+			name:  "異常系_削除済みのユーザー",
+			token: "refresh",
+			setup: func() {
+				mockTokenService.EXPECT().
+					ParseToken("refresh", model.TokenTypeRefresh).
+					Return(&model.TokenClaims{UserID: "user_001", Type: model.TokenTypeRefresh}, nil).
+					Times(1)
+				mockUserRepo.EXPECT().
+					GetUserByIDToData(gomock.Any(), "user_001").
+					Return(nil, errs.NewNotFoundError("user not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.Refresh(context.Background(), tt.token)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "access2", result.Tokens.AccessToken)
+			}
+		})
+	}
+}
+
+func TestAuthUseCase_GetCurrentUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	mockTokenService := mock_service.NewMockITokenService(ctrl)
+	usecase := newTestAuthUseCase(mockUserRepo, mockTokenService)
+	user := newTestUser(t, "password123")
+
+	mockUserRepo.EXPECT().
+		GetUserByIDToData(gomock.Any(), "user_001").
+		Return(user, nil).
+		Times(1)
+
+	ctx := authctx.WithPrincipal(context.Background(), &authctx.Principal{UserID: "user_001"})
+	got, err := usecase.GetCurrentUser(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, user, got)
+
+	// 匿名アクセス
+	got, err = usecase.GetCurrentUser(context.Background())
+	assert.Nil(t, got)
+	if appErr, ok := err.(*errs.AppError); assert.True(t, ok) {
+		assert.Equal(t, errs.EC004, appErr.Code)
+	}
+}
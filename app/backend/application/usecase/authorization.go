@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+)
+
+// authorize context のユーザーが操作を許可されているかを確認する
+// ルーティングの設定漏れがあっても書き込みが行われないよう、書き込み系のユースケースの先頭で呼び出す
+func authorize(ctx context.Context, permissions ...model.Permission) error {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return errs.NewUnauthorizedError("authentication is required")
+	}
+
+	role := model.Role(principal.Role)
+	for _, permission := range permissions {
+		if !role.Can(permission) {
+			return errs.NewForbiddenError(fmt.Sprintf("role '%s' does not have permission '%s'", role, permission))
+		}
+	}
+
+	return nil
+}
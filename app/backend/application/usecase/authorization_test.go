@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// contextWithRole 指定した役割のユーザーでログインしている context を返す
+func contextWithRole(role model.Role) context.Context {
+	return authctx.WithPrincipal(context.Background(), &authctx.Principal{
+		UserID: "user_" + string(role),
+		Role:   string(role),
+	})
+}
+
+// 書き込み系のユースケースはリポジトリを呼び出す前に権限を確認する
+// （モックに期待値を設定していないため、権限チェックを通過するとテストが失敗する）
+func TestAdminUseCases_Authorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	quizAdminUseCase := NewQuizAdminUseCase(mockQuizRepo, mockCategoryRepo)
+	categoryAdminUseCase := NewCategoryAdminUseCase(mockCategoryRepo, mockQuizRepo)
+	contentUseCase := NewContentUseCase(mockCategoryRepo, mockQuizRepo)
+	userAdminUseCase := NewUserAdminUseCase(mockUserRepo)
+	mediaUseCase := NewMediaUseCase(mockCategoryRepo, mock_service.NewMockIMediaStorage(ctrl), mock_service.NewMockIMediaSigner(ctrl))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		call    func(ctx context.Context) error
+		errType string
+	}{
+		{
+			name: "異常系_未ログインでクイズ作成",
+			ctx:  context.Background(),
+			call: func(ctx context.Context) error {
+				_, err := quizAdminUseCase.CreateQuiz(ctx, &dto.QuizRequest{})
+				return err
+			},
+			errType: errs.EC004,
+		},
+		{
+			name: "異常系_学習者がクイズ削除",
+			ctx:  contextWithRole(model.RoleLearner),
+			call: func(ctx context.Context) error {
+				return quizAdminUseCase.DeleteQuiz(ctx, "quiz_flag_001")
+			},
+			errType: errs.EC005,
+		},
+		{
+			name: "異常系_先生がカテゴリ作成",
+			ctx:  contextWithRole(model.RoleTeacher),
+			call: func(ctx context.Context) error {
+				_, err := categoryAdminUseCase.CreateCategory(ctx, &dto.CategoryRequest{ID: "instruments", Name: "楽器"})
+				return err
+			},
+			errType: errs.EC005,
+		},
+		{
+			name: "異常系_先生がコンテンツを一括インポート",
+			ctx:  contextWithRole(model.RoleTeacher),
+			call: func(ctx context.Context) error {
+				_, err := contentUseCase.Import(ctx, &model.ContentSet{}, dto.ImportOptions{})
+				return err
+			},
+			errType: errs.EC005,
+		},
+		{
+			name: "異常系_先生がユーザーの役割を変更",
+			ctx:  contextWithRole(model.RoleTeacher),
+			call: func(ctx context.Context) error {
+				_, err := userAdminUseCase.UpdateUserRole(ctx, "user_002", &dto.UpdateUserRoleRequest{Role: "admin"})
+				return err
+			},
+			errType: errs.EC005,
+		},
+		{
+			name: "異常系_学習者が画像をアップロード",
+			ctx:  contextWithRole(model.RoleLearner),
+			call: func(ctx context.Context) error {
+				_, err := mediaUseCase.UploadMedia(ctx, &dto.MediaUploadRequest{Category: "flags", File: strings.NewReader("\x89PNG\r\n\x1a\n"), Size: 8})
+				return err
+			},
+			errType: errs.EC005,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(tt.ctx)
+
+			if appErr, ok := err.(*errs.AppError); assert.True(t, ok) {
+				assert.Equal(t, tt.errType, appErr.Code)
+			}
+		})
+	}
+}
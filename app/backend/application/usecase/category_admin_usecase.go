@@ -0,0 +1,151 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+// categoryIDPattern カテゴリIDはキー（CATEGORY#<id>）とURLに使用するため英小文字・数字・-_のみ許可する
+var categoryIDPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+type ICategoryAdminUseCase interface {
+	CreateCategory(ctx context.Context, req *dto.CategoryRequest) (*model.Category, error)
+	UpdateCategory(ctx context.Context, id string, req *dto.CategoryRequest) (*model.Category, error)
+	DeleteCategory(ctx context.Context, id string, cascade bool) error
+}
+
+type CategoryAdminUseCase struct {
+	categoryRepo repository.ICategoryRepository
+	quizRepo     repository.IQuizRepository
+}
+
+func NewCategoryAdminUseCase(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) ICategoryAdminUseCase {
+	return &CategoryAdminUseCase{
+		categoryRepo: categoryRepo,
+		quizRepo:     quizRepo,
+	}
+}
+
+func (uc *CategoryAdminUseCase) CreateCategory(ctx context.Context, req *dto.CategoryRequest) (*model.Category, error) {
+	if err := authorize(ctx, model.PermissionWriteCategories); err != nil {
+		return nil, err
+	}
+
+	category := newCategoryFromRequest(req.ID, req)
+	if err := validateCategoryInput(category); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("category created", "categoryId", category.ID)
+
+	return category, nil
+}
+
+func (uc *CategoryAdminUseCase) UpdateCategory(ctx context.Context, id string, req *dto.CategoryRequest) (*model.Category, error) {
+	if err := authorize(ctx, model.PermissionWriteCategories); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errs.NewBadRequestError("category id is required")
+	}
+	if req.ID != "" && req.ID != id {
+		return nil, errs.NewBadRequestError("category id cannot be changed")
+	}
+
+	category := newCategoryFromRequest(id, req)
+	if err := validateCategoryInput(category); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("category updated", "categoryId", category.ID)
+
+	return category, nil
+}
+
+func (uc *CategoryAdminUseCase) DeleteCategory(ctx context.Context, id string, cascade bool) error {
+	if err := authorize(ctx, model.PermissionWriteCategories); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return errs.NewBadRequestError("category id is required")
+	}
+
+	// 削除中の印を付けてからクイズを数える（確認の後に登録・移動されたクイズがカテゴリのないまま残らないようにする）
+	// 削除中のカテゴリは取得できないため、存在の確認も印を付ける条件で行う（途中で失敗した削除をやり直せるようにする）
+	if err := uc.categoryRepo.MarkDeleting(ctx, id); err != nil {
+		return err
+	}
+
+	count, err := uc.quizRepo.CountQuizzesByCategoryToData(ctx, id)
+	if err != nil {
+		uc.unmarkDeleting(ctx, id)
+		return err
+	}
+
+	if count > 0 {
+		// クイズが残っているカテゴリは明示的に指定された場合のみまとめて削除する
+		if !cascade {
+			uc.unmarkDeleting(ctx, id)
+			return errs.NewBadRequestError(fmt.Sprintf("category '%s' still has %d quizzes", id, count))
+		}
+		if err := uc.quizRepo.DeleteByCategory(ctx, id); err != nil {
+			uc.unmarkDeleting(ctx, id)
+			return err
+		}
+	}
+
+	if err := uc.categoryRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	logging.FromContext(ctx).Info("category deleted", "categoryId", id, "deletedQuizzes", count)
+
+	return nil
+}
+
+func newCategoryFromRequest(id string, req *dto.CategoryRequest) *model.Category {
+	category := model.NewCategory(id, req.Name, req.Description, req.Thumbnail)
+	category.SortOrder = req.SortOrder
+	category.Translations = req.Translations
+	return category
+}
+
+// validateCategoryInput 保存前のカテゴリの入力チェック
+func validateCategoryInput(category *model.Category) error {
+	if category.ID == "" {
+		return errs.NewBadRequestError("category id is required")
+	}
+	if !categoryIDPattern.MatchString(category.ID) {
+		return errs.NewBadRequestError("category id must consist of lowercase letters, digits, '-' or '_'")
+	}
+	if category.Name == "" {
+		return errs.NewBadRequestError("name is required")
+	}
+	if fieldErrs := category.ValidateTranslations(); fieldErrs != nil {
+		return newValidationError(fieldErrs)
+	}
+	return nil
+}
+
+// unmarkDeleting 削除を取りやめた場合に削除中の印を外す（失敗しても元のエラーを返すため、ログのみ出力する）
+func (uc *CategoryAdminUseCase) unmarkDeleting(ctx context.Context, id string) {
+	if err := uc.categoryRepo.UnmarkDeleting(ctx, id); err != nil {
+		logging.FromContext(ctx).Error("failed to unmark category deletion", "categoryId", id, "error", err)
+	}
+}
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCategoryAdminUseCase_CreateCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewCategoryAdminUseCase(mockCategoryRepo, mockQuizRepo)
+
+	tests := []struct {
+		name    string
+		req     *dto.CategoryRequest
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			req:  &dto.CategoryRequest{ID: "instruments", Name: "楽器", Description: "いろいろな楽器の音を学習", SortOrder: 4},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_空のID",
+			req:     &dto.CategoryRequest{Name: "楽器"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_不正な文字を含むID",
+			req:     &dto.CategoryRequest{ID: "Instruments#1", Name: "楽器"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_翻訳の名前が空",
+			req: &dto.CategoryRequest{
+				ID:           "instruments",
+				Name:         "楽器",
+				Translations: map[string]model.CategoryText{"en": {Description: "Musical instruments"}},
+			},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_空の名前",
+			req:     &dto.CategoryRequest{ID: "instruments"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			category, err := usecase.CreateCategory(contextWithRole(model.RoleAdmin), tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, category)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.ID, category.ID)
+				assert.Equal(t, tt.req.SortOrder, category.SortOrder)
+				assert.Equal(t, "CATEGORY#"+tt.req.ID, category.PK)
+				assert.Equal(t, "META", category.SK)
+			}
+		})
+	}
+}
+
+func TestCategoryAdminUseCase_UpdateCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewCategoryAdminUseCase(mockCategoryRepo, mockQuizRepo)
+
+	tests := []struct {
+		name    string
+		id      string
+		req     *dto.CategoryRequest
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			id:   "flags",
+			req:  &dto.CategoryRequest{Name: "世界の国旗"},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_IDの変更",
+			id:      "flags",
+			req:     &dto.CategoryRequest{ID: "countries", Name: "世界の国旗"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_カテゴリが見つからない",
+			id:   "nonexistent",
+			req:  &dto.CategoryRequest{Name: "名前"},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			category, err := usecase.UpdateCategory(contextWithRole(model.RoleAdmin), tt.id, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, category)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.id, category.ID)
+				assert.Equal(t, tt.req.Name, category.Name)
+			}
+		})
+	}
+}
+
+func TestCategoryAdminUseCase_DeleteCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewCategoryAdminUseCase(mockCategoryRepo, mockQuizRepo)
+
+	tests := []struct {
+		name    string
+		id      string
+		cascade bool
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name:    "正常系_クイズなし",
+			id:      "flags",
+			cascade: false,
+			setup: func() {
+				// 削除中の印を付けてからクイズを数える
+				gomock.InOrder(
+					mockCategoryRepo.EXPECT().MarkDeleting(gomock.Any(), "flags").Return(nil).Times(1),
+					mockQuizRepo.EXPECT().CountQuizzesByCategoryToData(gomock.Any(), "flags").Return(0, nil).Times(1),
+					mockCategoryRepo.EXPECT().Delete(gomock.Any(), "flags").Return(nil).Times(1),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_クイズが残っている",
+			id:      "flags",
+			cascade: false,
+			setup: func() {
+				mockCategoryRepo.EXPECT().MarkDeleting(gomock.Any(), "flags").Return(nil).Times(1)
+				mockQuizRepo.EXPECT().CountQuizzesByCategoryToData(gomock.Any(), "flags").Return(10, nil).Times(1)
+				mockCategoryRepo.EXPECT().UnmarkDeleting(gomock.Any(), "flags").Return(nil).Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "正常系_カスケード削除",
+			id:      "flags",
+			cascade: true,
+			setup: func() {
+				mockCategoryRepo.EXPECT().MarkDeleting(gomock.Any(), "flags").Return(nil).Times(1)
+				mockQuizRepo.EXPECT().CountQuizzesByCategoryToData(gomock.Any(), "flags").Return(10, nil).Times(1)
+				gomock.InOrder(
+					mockQuizRepo.EXPECT().DeleteByCategory(gomock.Any(), "flags").Return(nil).Times(1),
+					mockCategoryRepo.EXPECT().Delete(gomock.Any(), "flags").Return(nil).Times(1),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_カスケード削除失敗",
+			id:      "flags",
+			cascade: true,
+			setup: func() {
+				mockCategoryRepo.EXPECT().MarkDeleting(gomock.Any(), "flags").Return(nil).Times(1)
+				mockQuizRepo.EXPECT().CountQuizzesByCategoryToData(gomock.Any(), "flags").Return(10, nil).Times(1)
+				mockQuizRepo.EXPECT().
+					DeleteByCategory(gomock.Any(), "flags").
+					Return(errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+				mockCategoryRepo.EXPECT().UnmarkDeleting(gomock.Any(), "flags").Return(nil).Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+		{
+			name:    "異常系_カテゴリが見つからない",
+			id:      "nonexistent",
+			cascade: false,
+			setup: func() {
+				// 存在の確認は削除中の印を付ける条件で行う
+				mockCategoryRepo.EXPECT().
+					MarkDeleting(gomock.Any(), "nonexistent").
+					Return(errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := usecase.DeleteCategory(contextWithRole(model.RoleAdmin), tt.id, tt.cascade)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
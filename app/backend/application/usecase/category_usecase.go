@@ -4,25 +4,107 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
 
+	"audio-slide-app/common/cursor"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
 	"audio-slide-app/domain/model"
 	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+)
+
+const (
+	// DefaultQuizPageSize クイズ一覧の1ページあたりのデフォルト件数
+	DefaultQuizPageSize = 20
+	// MaxQuizPageSize クイズ一覧の1ページあたりの最大件数
+	MaxQuizPageSize = 100
 )
 
 type ICategoryUseCase interface {
 	GetCategories(ctx context.Context) ([]*model.Category, error)
+	GetCategoryQuizzes(ctx context.Context, categoryID, pageCursor string, limit int) (*model.QuizPage, error)
 }
 
 type CategoryUseCase struct {
 	categoryRepo repository.ICategoryRepository
+	quizRepo     repository.IQuizRepository
+	cursorCodec  *cursor.Codec
+	mediaSigner  service.IMediaSigner
+	shuffle      model.ShuffleFunc
 }
 
-func NewCategoryUseCase(categoryRepo repository.ICategoryRepository) ICategoryUseCase {
+func NewCategoryUseCase(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository, cursorCodec *cursor.Codec, mediaSigner service.IMediaSigner) ICategoryUseCase {
 	return &CategoryUseCase{
 		categoryRepo: categoryRepo,
+		quizRepo:     quizRepo,
+		cursorCodec:  cursorCodec,
+		mediaSigner:  mediaSigner,
+		shuffle:      rand.Shuffle,
 	}
 }
 
+// quizPageCursor カーソルに埋め込む継続位置
+// 別カテゴリのカーソルを流用できないようカテゴリIDも含める
+type quizPageCursor struct {
+	Category string            `json:"c"`
+	Key      map[string]string `json:"k"`
+}
+
+// GetCategories context の言語の名前と説明に差し替えたカテゴリ一覧を返す
+// カテゴリはキャッシュで共有されるため、差し替えはコピーに対して行う
 func (uc *CategoryUseCase) GetCategories(ctx context.Context) ([]*model.Category, error) {
-	return uc.categoryRepo.GetCategoriesToData(ctx)
+	categories, err := uc.categoryRepo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := locale.FromContext(ctx)
+	localized := make([]*model.Category, 0, len(categories))
+	for _, category := range categories {
+		localized = append(localized, category.Localize(lang))
+	}
+	return localized, nil
+}
+
+func (uc *CategoryUseCase) GetCategoryQuizzes(ctx context.Context, categoryID, pageCursor string, limit int) (*model.QuizPage, error) {
+	if categoryID == "" {
+		return nil, errs.NewBadRequestError("category id is required")
+	}
+	if limit < 1 || limit > MaxQuizPageSize {
+		limit = DefaultQuizPageSize
+	}
+
+	var startKey map[string]string
+	if pageCursor != "" {
+		var decoded quizPageCursor
+		if err := uc.cursorCodec.Decode(pageCursor, &decoded); err != nil || decoded.Category != categoryID {
+			return nil, errs.NewBadRequestError("invalid cursor")
+		}
+		startKey = decoded.Key
+	}
+
+	if _, err := uc.categoryRepo.GetCategoryByIDToData(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	quizzes, nextKey, err := uc.quizRepo.GetQuizPageByCategoryToData(ctx, categoryID, limit, startKey)
+	if err != nil {
+		return nil, err
+	}
+	if quizzes, err = presentQuizzes(ctx, uc.quizRepo, uc.mediaSigner, quizzes, 0, uc.shuffle); err != nil {
+		return nil, err
+	}
+
+	page := &model.QuizPage{Quizzes: quizzes}
+	if nextKey != nil {
+		nextCursor, err := uc.cursorCodec.Encode(quizPageCursor{Category: categoryID, Key: nextKey})
+		if err != nil {
+			return nil, errs.NewInternalServerError(fmt.Errorf("failed to encode cursor: %w", err))
+		}
+		page.NextCursor = nextCursor
+	}
+
+	return page, nil
 }
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audio-slide-app/common/cursor"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCategoryUseCase_GetCategoryQuizzes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	codec := cursor.NewCodec([]byte("test-secret"))
+	usecase := NewCategoryUseCase(mockCategoryRepo, mockQuizRepo, codec, mock_service.NewMockIMediaSigner(ctrl))
+
+	nextKey := map[string]string{"PK": "CATEGORY#flags", "SK": "QUIZ#quiz_flag_002"}
+	flagsCursor, _ := codec.Encode(quizPageCursor{Category: "flags", Key: nextKey})
+	animalsCursor, _ := codec.Encode(quizPageCursor{Category: "animals", Key: nextKey})
+	quizzes := []*model.Quiz{
+		model.NewQuiz("quiz_flag_001", "/images/it.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", ""),
+		model.NewQuiz("quiz_flag_002", "/images/fr.png", "", "フランス", []string{"イタリア", "フランス"}, "flags", ""),
+	}
+
+	expectCategory := func() {
+		mockCategoryRepo.EXPECT().
+			GetCategoryByIDToData(gomock.Any(), "flags").
+			Return(model.NewCategory("flags", "国旗", "", ""), nil).
+			Times(1)
+	}
+
+	tests := []struct {
+		name           string
+		categoryID     string
+		cursor         string
+		limit          int
+		setup          func()
+		wantErr        bool
+		errType        string
+		wantNextCursor bool
+	}{
+		{
+			name:       "正常系_続きあり",
+			categoryID: "flags",
+			limit:      2,
+			setup: func() {
+				expectCategory()
+				mockQuizRepo.EXPECT().
+					GetQuizPageByCategoryToData(gomock.Any(), "flags", 2, nil).
+					Return(quizzes, nextKey, nil).
+					Times(1)
+			},
+			wantErr:        false,
+			wantNextCursor: true,
+		},
+		{
+			name:       "正常系_カーソル指定で最後のページ",
+			categoryID: "flags",
+			cursor:     flagsCursor,
+			limit:      0,
+			setup: func() {
+				expectCategory()
+				mockQuizRepo.EXPECT().
+					GetQuizPageByCategoryToData(gomock.Any(), "flags", DefaultQuizPageSize, nextKey).
+					Return(quizzes[:1], nil, nil).
+					Times(1)
+			},
+			wantErr:        false,
+			wantNextCursor: false,
+		},
+		{
+			name:       "異常系_改ざんされたカーソル",
+			categoryID: "flags",
+			cursor:     flagsCursor + "x",
+			setup:      func() {},
+			wantErr:    true,
+			errType:    errs.EC001,
+		},
+		{
+			name:       "異常系_別カテゴリのカーソル",
+			categoryID: "flags",
+			cursor:     animalsCursor,
+			setup:      func() {},
+			wantErr:    true,
+			errType:    errs.EC001,
+		},
+		{
+			name:       "異常系_カテゴリが見つからない",
+			categoryID: "nonexistent",
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+		{
+			name:       "異常系_リポジトリエラー",
+			categoryID: "flags",
+			setup: func() {
+				expectCategory()
+				mockQuizRepo.EXPECT().
+					GetQuizPageByCategoryToData(gomock.Any(), "flags", DefaultQuizPageSize, nil).
+					Return(nil, nil, errors.New("database error")).
+					Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			page, err := usecase.GetCategoryQuizzes(context.Background(), tt.categoryID, tt.cursor, tt.limit)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, page)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, page.Quizzes)
+				if tt.wantNextCursor {
+					var decoded quizPageCursor
+					assert.NoError(t, codec.Decode(page.NextCursor, &decoded))
+					assert.Equal(t, "flags", decoded.Category)
+					assert.Equal(t, nextKey, decoded.Key)
+				} else {
+					assert.Empty(t, page.NextCursor)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+// validateChoiceCount 出題時に指定された選択肢数をチェックする（0 はクイズごとのデフォルト）
+func validateChoiceCount(choiceCount int) error {
+	if choiceCount == 0 {
+		return nil
+	}
+	if choiceCount < model.MinChoiceCount || choiceCount > model.MaxChoiceCount {
+		return errs.NewBadRequestError(fmt.Sprintf("choices must be between %d and %d", model.MinChoiceCount, model.MaxChoiceCount))
+	}
+	return nil
+}
+
+// presentChoices 出題用に context の言語の文言に差し替え、選択肢を並べ替え、
+// 足りない分を同じカテゴリの他のクイズの正解で補ったコピーを返す
+// 保存時の選択肢は正解が先頭にあるため、レスポンスごとに並べ替える
+func presentChoices(ctx context.Context, quizRepo repository.IQuizRepository, quizzes []*model.Quiz, choiceCount int, shuffle model.ShuffleFunc) ([]*model.Quiz, error) {
+	lang := locale.FromContext(ctx)
+	pools := make(map[string][]string)
+	presented := make([]*model.Quiz, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		localized := quiz.Localize(lang)
+		var pool []string
+		if localized.NeedsDistractors(choiceCount) {
+			var err error
+			if pool, err = correctAnswerPool(ctx, quizRepo, pools, quiz.Category, quiz.ContentLanguage(lang)); err != nil {
+				return nil, err
+			}
+		}
+		choices := localized.PresentChoices(choiceCount, pool, shuffle)
+		if choiceCount > 0 && len(choices) < choiceCount {
+			// 誤答の候補が足りない（コンテンツの追加が必要）
+			logging.FromContext(ctx).Warn("not enough choices", "quizId", quiz.ID, "category", quiz.Category, "requested", choiceCount, "presented", len(choices))
+		}
+		presented = append(presented, localized.WithChoices(choices))
+	}
+	return presented, nil
+}
+
+// correctAnswerPool カテゴリと言語ごとの正解の一覧を取得する（取得済みの場合は pools から返す）
+func correctAnswerPool(ctx context.Context, quizRepo repository.IQuizRepository, pools map[string][]string, category, lang string) ([]string, error) {
+	key := category + "#" + lang
+	if pool, ok := pools[key]; ok {
+		return pool, nil
+	}
+	pool, err := quizRepo.GetCorrectAnswersByCategoryToData(ctx, category, lang)
+	if err != nil {
+		return nil, err
+	}
+	pools[key] = pool
+	return pool, nil
+}
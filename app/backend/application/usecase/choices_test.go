@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPresentChoices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	noShuffle := func(n int, swap func(i, j int)) {}
+
+	newQuiz := func(id, correctAnswer string, choices []string, generate bool) *model.Quiz {
+		quiz := model.NewQuiz(id, "/images/flags/"+id+".png", "", correctAnswer, choices, "flags", "")
+		quiz.GenerateDistractors = generate
+		return quiz
+	}
+
+	translated := newQuiz("quiz_003", "スペイン", []string{"スペイン"}, true)
+	translated.Translations = map[string]model.QuizText{
+		locale.English: {CorrectAnswer: "Spain", Choices: []string{"Spain"}, Explanation: "Red and yellow"},
+	}
+
+	tests := []struct {
+		name        string
+		lang        string
+		quizzes     []*model.Quiz
+		choiceCount int
+		setup       func()
+		wantErr     bool
+		errType     string
+		want        [][]string
+	}{
+		{
+			name: "正常系_登録済みの選択肢で足りる場合は正解一覧を取得しない",
+			quizzes: []*model.Quiz{
+				newQuiz("quiz_001", "イタリア", []string{"イタリア", "フランス"}, false),
+			},
+			choiceCount: 0,
+			setup:       func() {},
+			wantErr:     false,
+			want:        [][]string{{"イタリア", "フランス"}},
+		},
+		{
+			name: "正常系_同じカテゴリの正解一覧は1回だけ取得する",
+			quizzes: []*model.Quiz{
+				newQuiz("quiz_001", "イタリア", []string{"イタリア"}, true),
+				newQuiz("quiz_002", "ドイツ", nil, true),
+			},
+			choiceCount: 3,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return([]string{"イタリア", "ドイツ", "スペイン"}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			want:    [][]string{{"イタリア", "ドイツ", "スペイン"}, {"ドイツ", "イタリア", "スペイン"}},
+		},
+		{
+			name: "正常系_英語の翻訳と英語の正解一覧を使う",
+			lang: locale.English,
+			quizzes: []*model.Quiz{
+				translated,
+				newQuiz("quiz_002", "ドイツ", []string{"ドイツ"}, true),
+			},
+			choiceCount: 2,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", locale.English).
+					Return([]string{"Spain", "Germany"}, nil).
+					Times(1)
+				// 翻訳のないクイズは日本語で出題し、日本語の正解一覧を使う
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return([]string{"ドイツ", "イタリア"}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			want:    [][]string{{"Spain", "Germany"}, {"ドイツ", "イタリア"}},
+		},
+		{
+			name: "異常系_正解一覧の取得失敗",
+			quizzes: []*model.Quiz{
+				newQuiz("quiz_001", "イタリア", []string{"イタリア"}, true),
+			},
+			choiceCount: 0,
+			setup: func() {
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := context.Background()
+			if tt.lang != "" {
+				ctx = locale.WithLanguage(ctx, tt.lang)
+			}
+
+			quizzes, err := presentChoices(ctx, mockRepo, tt.quizzes, tt.choiceCount, noShuffle)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, quizzes)
+			} else {
+				assert.NoError(t, err)
+				got := make([][]string, 0, len(quizzes))
+				for _, quiz := range quizzes {
+					got = append(got, quiz.Choices)
+				}
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateChoiceCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		choiceCount int
+		wantErr     bool
+	}{
+		{name: "正常系_未指定", choiceCount: 0, wantErr: false},
+		{name: "正常系_最小", choiceCount: model.MinChoiceCount, wantErr: false},
+		{name: "正常系_最大", choiceCount: model.MaxChoiceCount, wantErr: false},
+		{name: "異常系_最小未満", choiceCount: 1, wantErr: true},
+		{name: "異常系_最大超過", choiceCount: model.MaxChoiceCount + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateChoiceCount(tt.choiceCount)
+
+			if tt.wantErr {
+				if appErr, ok := err.(*errs.AppError); assert.True(t, ok) {
+					assert.Equal(t, errs.EC001, appErr.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type IContentUseCase interface {
+	Import(ctx context.Context, content *model.ContentSet, opts dto.ImportOptions) (*model.ImportReport, error)
+	Export(ctx context.Context) (*model.ContentSet, error)
+	Lint(ctx context.Context) (*model.LintReport, error)
+	Reindex(ctx context.Context) (int, error)
+}
+
+type ContentUseCase struct {
+	categoryRepo repository.ICategoryRepository
+	quizRepo     repository.IQuizRepository
+	now          func() time.Time
+}
+
+func NewContentUseCase(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) IContentUseCase {
+	return &ContentUseCase{
+		categoryRepo: categoryRepo,
+		quizRepo:     quizRepo,
+		now:          time.Now,
+	}
+}
+
+func (uc *ContentUseCase) Import(ctx context.Context, content *model.ContentSet, opts dto.ImportOptions) (*model.ImportReport, error) {
+	if err := authorize(ctx, model.PermissionWriteCategories, model.PermissionWriteQuizzes); err != nil {
+		return nil, err
+	}
+
+	existingCategories, err := uc.categoryRepo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingQuizzes, err := uc.quizRepo.GetAllQuizzesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateContent(content, existingCategories, opts); err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	report := &model.ImportReport{DryRun: opts.DryRun}
+
+	// カテゴリの差分
+	categoryByID := make(map[string]*model.Category, len(existingCategories))
+	for _, category := range existingCategories {
+		categoryByID[category.ID] = category
+	}
+	importedCategoryIDs := make(map[string]bool, len(content.Categories))
+	var putCategories []*model.Category
+	for _, input := range content.Categories {
+		category := model.NewCategory(input.ID, input.Name, input.Description, input.Thumbnail)
+		category.SortOrder = input.SortOrder
+		category.Translations = input.Translations
+		importedCategoryIDs[category.ID] = true
+
+		current, exists := categoryByID[category.ID]
+		switch {
+		case !exists:
+			report.Categories.Created = append(report.Categories.Created, category.ID)
+			putCategories = append(putCategories, category)
+		case !opts.Upsert:
+			report.Categories.Skipped = append(report.Categories.Skipped, category.ID)
+		case reflect.DeepEqual(current, category):
+			report.Categories.Unchanged = append(report.Categories.Unchanged, category.ID)
+		default:
+			report.Categories.Updated = append(report.Categories.Updated, category.ID)
+			putCategories = append(putCategories, category)
+		}
+	}
+
+	// クイズの差分
+	quizByID := make(map[string]*model.Quiz, len(existingQuizzes))
+	for _, quiz := range existingQuizzes {
+		quizByID[quiz.ID] = quiz
+	}
+	importedQuizIDs := make(map[string]bool, len(content.Quizzes))
+	var putQuizzes, deleteQuizzes []*model.Quiz
+	for _, input := range content.Quizzes {
+		quiz := model.NewQuiz(input.ID, input.QuestionImageURL, input.QuestionAudioURL, input.CorrectAnswer, input.Choices, input.Category, input.Explanation)
+		quiz.GenerateDistractors = input.GenerateDistractors
+		quiz.Translations = input.Translations
+		quiz.AudioAssets = input.AudioAssets
+		quiz.CreatedAt = now
+		quiz.UpdatedAt = now
+		importedQuizIDs[quiz.ID] = true
+
+		current, exists := quizByID[quiz.ID]
+		switch {
+		case !exists:
+			report.Quizzes.Created = append(report.Quizzes.Created, quiz.ID)
+			putQuizzes = append(putQuizzes, quiz)
+		case !opts.Upsert:
+			report.Quizzes.Skipped = append(report.Quizzes.Skipped, quiz.ID)
+		case sameQuizContent(current, quiz):
+			report.Quizzes.Unchanged = append(report.Quizzes.Unchanged, quiz.ID)
+		default:
+			quiz.CreatedAt = current.CreatedAt
+			quiz.RandomKey = current.RandomKey
+			report.Quizzes.Updated = append(report.Quizzes.Updated, quiz.ID)
+			putQuizzes = append(putQuizzes, quiz)
+			// カテゴリが変わった場合は旧パーティションのアイテムを削除する
+			if current.PK != quiz.PK {
+				deleteQuizzes = append(deleteQuizzes, current)
+			}
+		}
+	}
+
+	// 入力に含まれないアイテムの削除
+	var deleteCategoryIDs []string
+	if opts.Prune {
+		for _, quiz := range existingQuizzes {
+			if !importedQuizIDs[quiz.ID] {
+				report.Quizzes.Deleted = append(report.Quizzes.Deleted, quiz.ID)
+				deleteQuizzes = append(deleteQuizzes, quiz)
+			}
+		}
+		for _, category := range existingCategories {
+			if !importedCategoryIDs[category.ID] {
+				report.Categories.Deleted = append(report.Categories.Deleted, category.ID)
+				deleteCategoryIDs = append(deleteCategoryIDs, category.ID)
+			}
+		}
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	// カテゴリ→クイズの順に登録し、クイズ→カテゴリの順に削除する
+	if err := uc.categoryRepo.BatchPut(ctx, putCategories); err != nil {
+		return nil, err
+	}
+	if err := uc.quizRepo.BatchPut(ctx, putQuizzes); err != nil {
+		return nil, err
+	}
+	if err := uc.quizRepo.BatchDelete(ctx, deleteQuizzes); err != nil {
+		return nil, err
+	}
+	for _, id := range deleteCategoryIDs {
+		if err := uc.categoryRepo.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	logging.FromContext(ctx).Info("content imported",
+		"categoriesWritten", len(putCategories), "categoriesDeleted", len(deleteCategoryIDs),
+		"quizzesWritten", len(putQuizzes), "quizzesDeleted", len(deleteQuizzes))
+
+	return report, nil
+}
+
+func (uc *ContentUseCase) Export(ctx context.Context) (*model.ContentSet, error) {
+	categories, err := uc.categoryRepo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quizzes, err := uc.quizRepo.GetAllQuizzesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 差分が見やすいようカテゴリ、ID順に並べる
+	sort.SliceStable(quizzes, func(i, j int) bool {
+		if quizzes[i].Category != quizzes[j].Category {
+			return quizzes[i].Category < quizzes[j].Category
+		}
+		return quizzes[i].ID < quizzes[j].ID
+	})
+
+	return &model.ContentSet{
+		Categories: categories,
+		Quizzes:    quizzes,
+	}, nil
+}
+
+// Lint テーブル上のすべてのクイズをチェックし、問題のあるアイテムを報告する
+func (uc *ContentUseCase) Lint(ctx context.Context) (*model.LintReport, error) {
+	categories, err := uc.categoryRepo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	quizzes, err := uc.quizRepo.GetAllQuizzesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	knownCategories := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		knownCategories[category.ID] = true
+	}
+	quizCountByID := make(map[string]int, len(quizzes))
+	for _, quiz := range quizzes {
+		quizCountByID[quiz.ID]++
+	}
+
+	sort.SliceStable(quizzes, func(i, j int) bool {
+		if quizzes[i].Category != quizzes[j].Category {
+			return quizzes[i].Category < quizzes[j].Category
+		}
+		return quizzes[i].ID < quizzes[j].ID
+	})
+
+	report := &model.LintReport{Checked: len(quizzes)}
+	for _, quiz := range quizzes {
+		fieldErrs := quiz.Validate()
+
+		// テーブル上にしか起こりえない不整合もあわせてチェックする
+		if quiz.Category != "" && !knownCategories[quiz.Category] {
+			fieldErrs = append(fieldErrs, model.FieldError{Field: "category", Message: fmt.Sprintf("category '%s' does not exist", quiz.Category)})
+		}
+		if quiz.PK != "CATEGORY#"+quiz.Category || quiz.SK != "QUIZ#"+quiz.ID {
+			fieldErrs = append(fieldErrs, model.FieldError{Field: "PK/SK", Message: fmt.Sprintf("key '%s'/'%s' does not match id and category", quiz.PK, quiz.SK)})
+		}
+		if quizCountByID[quiz.ID] > 1 {
+			fieldErrs = append(fieldErrs, model.FieldError{Field: "id", Message: "is used by multiple items"})
+		}
+
+		if len(fieldErrs) > 0 {
+			report.Issues = append(report.Issues, model.LintIssue{
+				QuizID:   quiz.ID,
+				Category: quiz.Category,
+				Errors:   fieldErrs,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// Reindex ランダム出題用のキー（randomKey）を持たないクイズを再保存し、ランダム出題の対象に含める
+// randomKey 導入前に登録されたクイズの移行用で、再保存したクイズの件数を返す
+func (uc *ContentUseCase) Reindex(ctx context.Context) (int, error) {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return 0, err
+	}
+
+	quizzes, err := uc.quizRepo.GetAllQuizzesToData(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var targets []*model.Quiz
+	for _, quiz := range quizzes {
+		if quiz.RandomKey == "" {
+			targets = append(targets, quiz)
+		}
+	}
+
+	if err := uc.quizRepo.BatchPut(ctx, targets); err != nil {
+		return 0, err
+	}
+
+	return len(targets), nil
+}
+
+// validateContent 書き込み前に入力全体をチェックする（1件でも不正があれば何も書き込まない）
+func validateContent(content *model.ContentSet, existingCategories []*model.Category, opts dto.ImportOptions) error {
+	knownCategories := make(map[string]bool)
+	if !opts.Prune {
+		for _, category := range existingCategories {
+			knownCategories[category.ID] = true
+		}
+	}
+
+	seenCategories := make(map[string]bool, len(content.Categories))
+	for _, category := range content.Categories {
+		if err := validateCategoryInput(category); err != nil {
+			return withItemContext(err, "category", category.ID)
+		}
+		if seenCategories[category.ID] {
+			return errs.NewBadRequestError(fmt.Sprintf("category '%s' is duplicated", category.ID))
+		}
+		seenCategories[category.ID] = true
+		knownCategories[category.ID] = true
+	}
+
+	seenQuizzes := make(map[string]bool, len(content.Quizzes))
+	for _, quiz := range content.Quizzes {
+		if err := validateQuizFields(quiz); err != nil {
+			return withItemContext(err, "quiz", quiz.ID)
+		}
+		if seenQuizzes[quiz.ID] {
+			return errs.NewBadRequestError(fmt.Sprintf("quiz '%s' is duplicated", quiz.ID))
+		}
+		seenQuizzes[quiz.ID] = true
+		if !knownCategories[quiz.Category] {
+			return errs.NewBadRequestError(fmt.Sprintf("quiz '%s': category '%s' does not exist", quiz.ID, quiz.Category))
+		}
+	}
+
+	return nil
+}
+
+// withItemContext どのアイテムでエラーになったかを詳細に付与する
+func withItemContext(err error, kind, id string) error {
+	if appErr, ok := err.(*errs.AppError); ok {
+		return errs.NewValidationError(fmt.Sprintf("%s '%s': %s", kind, id, appErr.Details), appErr.Fields)
+	}
+	return err
+}
+
+// sameQuizContent 日時を除いたクイズの内容が同じかを判定する
+func sameQuizContent(a, b *model.Quiz) bool {
+	return a.ID == b.ID &&
+		a.QuestionImageURL == b.QuestionImageURL &&
+		a.QuestionAudioURL == b.QuestionAudioURL &&
+		a.CorrectAnswer == b.CorrectAnswer &&
+		reflect.DeepEqual(a.Choices, b.Choices) &&
+		a.Category == b.Category &&
+		a.Explanation == b.Explanation &&
+		a.GenerateDistractors == b.GenerateDistractors &&
+		reflect.DeepEqual(a.Translations, b.Translations) &&
+		reflect.DeepEqual(a.AudioAssets, b.AudioAssets) &&
+		a.PK == b.PK &&
+		a.SK == b.SK
+}
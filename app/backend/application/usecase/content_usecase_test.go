@@ -0,0 +1,346 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentUseCase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	usecase := &ContentUseCase{
+		categoryRepo: mockCategoryRepo,
+		quizRepo:     mockQuizRepo,
+		now:          func() time.Time { return now },
+	}
+
+	existingCategories := func() []*model.Category {
+		return []*model.Category{
+			model.NewCategory("flags", "国旗", "世界の国旗", ""),
+			model.NewCategory("animals", "動物", "動物の鳴き声", ""),
+		}
+	}
+	existingQuizzes := func() []*model.Quiz {
+		return []*model.Quiz{
+			model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", ""),
+			model.NewQuiz("quiz_animal_001", "/images/lion.png", "/audio/lion.mp3", "ライオン", []string{"ライオン", "トラ"}, "animals", ""),
+		}
+	}
+	expectLoad := func() {
+		mockCategoryRepo.EXPECT().GetCategoriesToData(gomock.Any()).Return(existingCategories(), nil).Times(1)
+		mockQuizRepo.EXPECT().GetAllQuizzesToData(gomock.Any()).Return(existingQuizzes(), nil).Times(1)
+	}
+
+	tests := []struct {
+		name       string
+		content    *model.ContentSet
+		opts       dto.ImportOptions
+		setup      func()
+		wantErr    bool
+		errType    string
+		wantReport *model.ImportReport
+	}{
+		{
+			name: "正常系_新規作成と既存スキップ",
+			content: &model.ContentSet{
+				Categories: []*model.Category{model.NewCategory("words", "言葉", "", "")},
+				Quizzes: []*model.Quiz{
+					model.NewQuiz("quiz_word_001", "/images/apple.png", "/audio/apple.mp3", "りんご", []string{"りんご", "みかん"}, "words", ""),
+					model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "スペイン"}, "flags", ""),
+				},
+			},
+			opts: dto.ImportOptions{},
+			setup: func() {
+				expectLoad()
+				mockCategoryRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Len(1)).
+					Return(nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Len(1)).
+					Return(nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchDelete(gomock.Any(), gomock.Len(0)).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantReport: &model.ImportReport{
+				Categories: model.ImportResult{Created: []string{"words"}},
+				Quizzes:    model.ImportResult{Created: []string{"quiz_word_001"}, Skipped: []string{"quiz_flag_001"}},
+			},
+		},
+		{
+			name: "正常系_upsertでカテゴリ移動",
+			content: &model.ContentSet{
+				Quizzes: []*model.Quiz{
+					model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "フランス"}, "animals", ""),
+					model.NewQuiz("quiz_animal_001", "/images/lion.png", "/audio/lion.mp3", "ライオン", []string{"ライオン", "トラ"}, "animals", ""),
+				},
+			},
+			opts: dto.ImportOptions{Upsert: true},
+			setup: func() {
+				expectLoad()
+				mockCategoryRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Len(0)).
+					Return(nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Len(1)).
+					Return(nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchDelete(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, quizzes []*model.Quiz) error {
+						assert.Len(t, quizzes, 1)
+						assert.Equal(t, "CATEGORY#flags", quizzes[0].PK)
+						return nil
+					}).
+					Times(1)
+			},
+			wantErr: false,
+			wantReport: &model.ImportReport{
+				Quizzes: model.ImportResult{Updated: []string{"quiz_flag_001"}, Unchanged: []string{"quiz_animal_001"}},
+			},
+		},
+		{
+			name: "正常系_翻訳と誤答の自動生成の変更は更新扱い",
+			content: &model.ContentSet{
+				Quizzes: func() []*model.Quiz {
+					translated := model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+					translated.Translations = map[string]model.QuizText{"en": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France"}}}
+					generated := model.NewQuiz("quiz_animal_001", "/images/lion.png", "/audio/lion.mp3", "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+					generated.GenerateDistractors = true
+					return []*model.Quiz{translated, generated}
+				}(),
+			},
+			opts: dto.ImportOptions{Upsert: true},
+			setup: func() {
+				expectLoad()
+				mockCategoryRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Len(0)).
+					Return(nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchPut(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, quizzes []*model.Quiz) error {
+						assert.Len(t, quizzes, 2)
+						assert.Equal(t, "Italy", quizzes[0].Translations["en"].CorrectAnswer)
+						assert.True(t, quizzes[1].GenerateDistractors)
+						return nil
+					}).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					BatchDelete(gomock.Any(), gomock.Len(0)).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantReport: &model.ImportReport{
+				Quizzes: model.ImportResult{Updated: []string{"quiz_flag_001", "quiz_animal_001"}},
+			},
+		},
+		{
+			name: "正常系_dry-runとprune",
+			content: &model.ContentSet{
+				Categories: []*model.Category{model.NewCategory("flags", "国旗", "世界の国旗", "")},
+				Quizzes: []*model.Quiz{
+					model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", ""),
+				},
+			},
+			opts: dto.ImportOptions{DryRun: true, Upsert: true, Prune: true},
+			setup: func() {
+				expectLoad()
+			},
+			wantErr: false,
+			wantReport: &model.ImportReport{
+				DryRun:     true,
+				Categories: model.ImportResult{Unchanged: []string{"flags"}, Deleted: []string{"animals"}},
+				Quizzes:    model.ImportResult{Unchanged: []string{"quiz_flag_001"}, Deleted: []string{"quiz_animal_001"}},
+			},
+		},
+		{
+			name: "異常系_存在しないカテゴリ",
+			content: &model.ContentSet{
+				Quizzes: []*model.Quiz{
+					model.NewQuiz("quiz_x_001", "/images/x.png", "/audio/x.mp3", "A", []string{"A", "B"}, "nonexistent", ""),
+				},
+			},
+			opts: dto.ImportOptions{},
+			setup: func() {
+				expectLoad()
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_不正なクイズ",
+			content: &model.ContentSet{
+				Quizzes: []*model.Quiz{
+					model.NewQuiz("quiz_flag_002", "/images/x.png", "/audio/x.mp3", "ドイツ", []string{"イタリア", "フランス"}, "flags", ""),
+				},
+			},
+			opts: dto.ImportOptions{},
+			setup: func() {
+				expectLoad()
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_IDの重複",
+			content: &model.ContentSet{
+				Categories: []*model.Category{
+					model.NewCategory("words", "言葉", "", ""),
+					model.NewCategory("words", "言葉", "", ""),
+				},
+			},
+			opts: dto.ImportOptions{},
+			setup: func() {
+				expectLoad()
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_リポジトリエラー",
+			content: &model.ContentSet{},
+			opts:    dto.ImportOptions{},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoriesToData(gomock.Any()).
+					Return(nil, errors.New("database error")).
+					Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			report, err := usecase.Import(contextWithRole(model.RoleAdmin), tt.content, tt.opts)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, report)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantReport, report)
+			}
+		})
+	}
+}
+
+func TestContentUseCase_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewContentUseCase(mockCategoryRepo, mockQuizRepo)
+
+	mockCategoryRepo.EXPECT().
+		GetCategoriesToData(gomock.Any()).
+		Return([]*model.Category{model.NewCategory("flags", "国旗", "", "")}, nil).
+		Times(1)
+	mockQuizRepo.EXPECT().
+		GetAllQuizzesToData(gomock.Any()).
+		Return([]*model.Quiz{
+			{ID: "quiz_flag_002", Category: "flags"},
+			{ID: "quiz_animal_001", Category: "animals"},
+			{ID: "quiz_flag_001", Category: "flags"},
+		}, nil).
+		Times(1)
+
+	content, err := usecase.Export(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, content.Categories, 1)
+	var ids []string
+	for _, quiz := range content.Quizzes {
+		ids = append(ids, quiz.ID)
+	}
+	assert.Equal(t, []string{"quiz_animal_001", "quiz_flag_001", "quiz_flag_002"}, ids)
+}
+
+func TestContentUseCase_Lint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewContentUseCase(mockCategoryRepo, mockQuizRepo)
+
+	validQuiz := model.NewQuiz("quiz_flag_001", "/images/italy.png", "/audio/italy.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	typoQuiz := model.NewQuiz("quiz_flag_002", "/images/france.png", "/audio/france.mp3", "フランズ", []string{"フランス", "イタリア"}, "flags", "")
+	orphanQuiz := model.NewQuiz("quiz_word_001", "/images/apple.png", "/audio/apple.mp3", "りんご", []string{"りんご", "みかん"}, "words", "")
+
+	mockCategoryRepo.EXPECT().
+		GetCategoriesToData(gomock.Any()).
+		Return([]*model.Category{model.NewCategory("flags", "国旗", "", "")}, nil).
+		Times(1)
+	mockQuizRepo.EXPECT().
+		GetAllQuizzesToData(gomock.Any()).
+		Return([]*model.Quiz{orphanQuiz, typoQuiz, validQuiz}, nil).
+		Times(1)
+
+	report, err := usecase.Lint(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, report.Checked)
+	if assert.Len(t, report.Issues, 2) {
+		assert.Equal(t, "quiz_flag_002", report.Issues[0].QuizID)
+		assert.Equal(t, "correctAnswer", report.Issues[0].Errors[0].Field)
+		assert.Equal(t, "quiz_word_001", report.Issues[1].QuizID)
+		assert.Equal(t, "category", report.Issues[1].Errors[0].Field)
+	}
+}
+
+func TestContentUseCase_Reindex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	usecase := NewContentUseCase(mockCategoryRepo, mockQuizRepo)
+
+	indexed := model.NewQuiz("quiz_flag_001", "/images/italy.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	indexed.RandomKey = "8000000000000000"
+	notIndexed := model.NewQuiz("quiz_flag_002", "/images/france.png", "", "フランス", []string{"イタリア", "フランス"}, "flags", "")
+
+	mockQuizRepo.EXPECT().
+		GetAllQuizzesToData(gomock.Any()).
+		Return([]*model.Quiz{indexed, notIndexed}, nil).
+		Times(1)
+	mockQuizRepo.EXPECT().
+		BatchPut(gomock.Any(), []*model.Quiz{notIndexed}).
+		Return(nil).
+		Times(1)
+
+	count, err := usecase.Reindex(contextWithRole(model.RoleAdmin))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,92 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/service"
+)
+
+type IHealthUseCase interface {
+	Live(ctx context.Context) *dto.HealthResponse
+	Ready(ctx context.Context) *dto.HealthResponse
+}
+
+type HealthUseCase struct {
+	checkers []service.IHealthChecker
+	timeout  time.Duration
+	now      func() time.Time
+}
+
+func NewHealthUseCase(checkers []service.IHealthChecker, timeout time.Duration) IHealthUseCase {
+	return &HealthUseCase{
+		checkers: checkers,
+		timeout:  timeout,
+		now:      time.Now,
+	}
+}
+
+// Live プロセスが応答できるかだけを返す（依存先は確認しない）
+// 依存先の障害でコンテナが再起動され続けないよう、依存先の確認は Ready で行う
+func (uc *HealthUseCase) Live(ctx context.Context) *dto.HealthResponse {
+	return dto.NewHealthResponse(uc.now(), nil)
+}
+
+// Ready すべての依存先を並行に確認し、依存先ごとの結果と所要時間を返す
+// 1つでも失敗またはタイムアウトした場合は error とする
+func (uc *HealthUseCase) Ready(ctx context.Context) *dto.HealthResponse {
+	checks := make([]dto.HealthCheckResult, len(uc.checkers))
+	var wg sync.WaitGroup
+	for i, checker := range uc.checkers {
+		wg.Add(1)
+		go func(i int, checker service.IHealthChecker) {
+			defer wg.Done()
+			checks[i] = uc.check(ctx, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	return dto.NewHealthResponse(uc.now(), checks)
+}
+
+// check context のタイムアウトに従わない確認があっても、タイムアウトで打ち切って結果を返す
+func (uc *HealthUseCase) check(ctx context.Context, checker service.IHealthChecker) dto.HealthCheckResult {
+	ctx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
+
+	start := uc.now()
+	done := make(chan error, 1)
+	go func() {
+		done <- checker.Check(ctx)
+	}()
+
+	status := dto.HealthStatusOK
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		status = dto.HealthStatusTimeout
+	} else if err != nil {
+		status = dto.HealthStatusError
+	}
+	latency := uc.now().Sub(start)
+
+	if status != dto.HealthStatusOK {
+		logging.FromContext(ctx).Warn("health check failed", "check", checker.Name(), "status", status, "latencyMs", latency.Milliseconds(), "error", err)
+	}
+
+	return dto.HealthCheckResult{
+		Name:      checker.Name(),
+		Status:    status,
+		LatencyMs: latency.Milliseconds(),
+	}
+}
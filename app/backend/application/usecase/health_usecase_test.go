@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/service"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHealthUseCase_Ready(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newChecker := func(name string, check func(ctx context.Context) error) service.IHealthChecker {
+		checker := mock_service.NewMockIHealthChecker(ctrl)
+		checker.EXPECT().Name().Return(name).AnyTimes()
+		checker.EXPECT().Check(gomock.Any()).DoAndReturn(check).Times(1)
+		return checker
+	}
+	ok := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return errors.New("ResourceNotFoundException") }
+	// context のタイムアウトに従う確認
+	wait := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	// context のタイムアウトに従わない確認
+	hang := func(context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		checkers   func() []service.IHealthChecker
+		wantStatus string
+		wantChecks []string
+	}{
+		{
+			name: "正常系_すべて成功",
+			checkers: func() []service.IHealthChecker {
+				return []service.IHealthChecker{newChecker("dynamodb", ok), newChecker("config", ok)}
+			},
+			wantStatus: dto.HealthStatusOK,
+			wantChecks: []string{"dynamodb:ok", "config:ok"},
+		},
+		{
+			name: "正常系_確認対象なし",
+			checkers: func() []service.IHealthChecker {
+				return nil
+			},
+			wantStatus: dto.HealthStatusOK,
+			wantChecks: nil,
+		},
+		{
+			name: "異常系_1つが失敗",
+			checkers: func() []service.IHealthChecker {
+				return []service.IHealthChecker{newChecker("dynamodb", fail), newChecker("config", ok)}
+			},
+			wantStatus: dto.HealthStatusError,
+			wantChecks: []string{"dynamodb:error", "config:ok"},
+		},
+		{
+			name: "異常系_タイムアウト",
+			checkers: func() []service.IHealthChecker {
+				return []service.IHealthChecker{newChecker("dynamodb", wait), newChecker("mediaBucket", hang), newChecker("config", ok)}
+			},
+			wantStatus: dto.HealthStatusError,
+			wantChecks: []string{"dynamodb:timeout", "mediaBucket:timeout", "config:ok"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := &HealthUseCase{
+				checkers: tt.checkers(),
+				timeout:  50 * time.Millisecond,
+				now:      time.Now,
+			}
+
+			start := time.Now()
+			result := usecase.Ready(context.Background())
+
+			// 確認は並行に行い、タイムアウトで打ち切る
+			assert.Less(t, time.Since(start), 500*time.Millisecond)
+			assert.Equal(t, tt.wantStatus, result.Status)
+			var checks []string
+			for _, check := range result.Checks {
+				checks = append(checks, check.Name+":"+check.Status)
+				assert.GreaterOrEqual(t, check.LatencyMs, int64(0))
+			}
+			assert.Equal(t, tt.wantChecks, checks)
+		})
+	}
+}
+
+func TestHealthUseCase_Live(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	usecase := &HealthUseCase{now: func() time.Time { return now }}
+
+	result := usecase.Live(context.Background())
+
+	assert.Equal(t, &dto.HealthResponse{Status: dto.HealthStatusOK, Timestamp: now}, result)
+}
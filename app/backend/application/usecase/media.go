@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+)
+
+// presentQuizzes 出題用の選択肢を作成し、画像・音声を期限付きの URL に差し替えたコピーを返す
+func presentQuizzes(ctx context.Context, quizRepo repository.IQuizRepository, mediaSigner service.IMediaSigner, quizzes []*model.Quiz, choiceCount int, shuffle model.ShuffleFunc) ([]*model.Quiz, error) {
+	presented, err := presentChoices(ctx, quizRepo, quizzes, choiceCount, shuffle)
+	if err != nil {
+		return nil, err
+	}
+	return signMedia(ctx, mediaSigner, presented)
+}
+
+// signMedia オブジェクトキーで保存された画像・音声を期限付きの URL に差し替えたコピーを返す
+// 署名対象のバケットの公開 URL で保存されたもの（オブジェクトキーの導入前のデータ）も同様に署名する
+// それ以外の URL やパスで保存されたもの（外部の配信先など）はそのまま返す
+func signMedia(ctx context.Context, mediaSigner service.IMediaSigner, quizzes []*model.Quiz) ([]*model.Quiz, error) {
+	resolve := func(ref string) (string, error) {
+		if model.IsObjectKey(ref) {
+			return mediaSigner.SignURL(ctx, ref)
+		}
+		if !model.IsAbsoluteMediaURL(ref) {
+			return ref, nil
+		}
+		if key, ok := mediaSigner.ObjectKeyFromURL(ref); ok {
+			return mediaSigner.SignURL(ctx, key)
+		}
+		return ref, nil
+	}
+
+	signed := make([]*model.Quiz, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		resolved, err := quiz.WithMediaURLs(resolve)
+		if err != nil {
+			return nil, err
+		}
+		signed = append(signed, resolved)
+	}
+	return signed, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMediaSigner := mock_service.NewMockIMediaSigner(ctrl)
+
+	newQuiz := func() *model.Quiz {
+		quiz := model.NewQuiz("quiz_animal_001", "images/animals/lion.png", "https://cdn.example.com/audio/lion.mp3", "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+		quiz.AudioAssets = []model.AudioAsset{
+			{Kind: model.AudioKindReading, Language: "ja", URL: "audio/animals/lion_ja.mp3", MimeType: "audio/mpeg"},
+			{Kind: model.AudioKindSound, URL: "/audio/animals/lion_roar.mp3", MimeType: "audio/mpeg"},
+			// オブジェクトキーの導入前に保存されたバケットの公開 URL
+			{Kind: model.AudioKindReading, Language: "en", URL: "https://audio-slide-app-assets.s3.amazonaws.com/audio/animals/lion_en.mp3", MimeType: "audio/mpeg"},
+		}
+		return quiz
+	}
+
+	tests := []struct {
+		name      string
+		setup     func()
+		wantImage string
+		wantAudio []string
+		wantErr   bool
+		errType   string
+	}{
+		{
+			name: "正常系_オブジェクトキーとバケットの URL を署名",
+			setup: func() {
+				mockMediaSigner.EXPECT().
+					ObjectKeyFromURL("https://cdn.example.com/audio/lion.mp3").
+					Return("", false).
+					Times(1)
+				mockMediaSigner.EXPECT().
+					ObjectKeyFromURL("https://audio-slide-app-assets.s3.amazonaws.com/audio/animals/lion_en.mp3").
+					Return("audio/animals/lion_en.mp3", true).
+					Times(1)
+				for _, key := range []string{"images/animals/lion.png", "audio/animals/lion_ja.mp3", "audio/animals/lion_en.mp3"} {
+					mockMediaSigner.EXPECT().
+						SignURL(gomock.Any(), key).
+						Return("https://signed.example.com/"+key+"?Expires=1", nil).
+						Times(1)
+				}
+			},
+			wantImage: "https://signed.example.com/images/animals/lion.png?Expires=1",
+			wantAudio: []string{"https://signed.example.com/audio/animals/lion_ja.mp3?Expires=1", "/audio/animals/lion_roar.mp3", "https://signed.example.com/audio/animals/lion_en.mp3?Expires=1"},
+		},
+		{
+			name: "異常系_署名エラー",
+			setup: func() {
+				mockMediaSigner.EXPECT().
+					SignURL(gomock.Any(), "images/animals/lion.png").
+					Return("", errs.NewInternalServerError(errors.New("presign error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			quiz := newQuiz()
+			result, err := signMedia(context.Background(), mockMediaSigner, []*model.Quiz{quiz})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			assert.Equal(t, tt.wantImage, result[0].QuestionImageURL)
+			assert.Equal(t, "https://cdn.example.com/audio/lion.mp3", result[0].QuestionAudioURL)
+			var audio []string
+			for _, asset := range result[0].AudioAssets {
+				audio = append(audio, asset.URL)
+			}
+			assert.Equal(t, tt.wantAudio, audio)
+			// 元のクイズは書き換えない
+			assert.Equal(t, "images/animals/lion.png", quiz.QuestionImageURL)
+			assert.Equal(t, "audio/animals/lion_ja.mp3", quiz.AudioAssets[0].URL)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+)
+
+type IMediaUseCase interface {
+	UploadMedia(ctx context.Context, req *dto.MediaUploadRequest) (*dto.MediaUploadResponse, error)
+}
+
+type MediaUseCase struct {
+	categoryRepo repository.ICategoryRepository
+	mediaStorage service.IMediaStorage
+	mediaSigner  service.IMediaSigner
+	newID        func() string
+}
+
+func NewMediaUseCase(categoryRepo repository.ICategoryRepository, mediaStorage service.IMediaStorage, mediaSigner service.IMediaSigner) IMediaUseCase {
+	return &MediaUseCase{
+		categoryRepo: categoryRepo,
+		mediaStorage: mediaStorage,
+		mediaSigner:  mediaSigner,
+		newID:        idgen.New,
+	}
+}
+
+// UploadMedia 画像・音声ファイルを保存し、クイズに指定するオブジェクトキーを返す
+// 同じ名前のファイルで既存のクイズの画像・音声を上書きしないよう、ファイル名は毎回新しく発行する
+func (uc *MediaUseCase) UploadMedia(ctx context.Context, req *dto.MediaUploadRequest) (*dto.MediaUploadResponse, error) {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return nil, err
+	}
+
+	contentType, err := detectMediaContentType(req.File)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &model.MediaUpload{
+		Category:    req.Category,
+		ContentType: contentType,
+		Size:        req.Size,
+	}
+	if fieldErrs := upload.Validate(); fieldErrs != nil {
+		return nil, newValidationError(fieldErrs)
+	}
+
+	if _, err := uc.categoryRepo.GetCategoryByIDToData(ctx, upload.Category); err != nil {
+		if errs.IsNotFound(err) {
+			return nil, errs.NewBadRequestError(fmt.Sprintf("category '%s' does not exist", upload.Category))
+		}
+		return nil, err
+	}
+
+	key := upload.Key(uc.newID())
+	if err := uc.mediaStorage.Put(ctx, key, req.File, contentType); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("media uploaded", "key", key, "contentType", contentType, "size", upload.Size)
+
+	url, err := uc.mediaSigner.SignURL(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MediaUploadResponse{
+		Key:         key,
+		URL:         url,
+		ContentType: contentType,
+		Size:        upload.Size,
+	}, nil
+}
+
+// detectMediaContentType ファイルの先頭からMIMEタイプを判定し、読み取り位置を先頭に戻す
+// クライアントが申告する Content-Type は信用しない
+func detectMediaContentType(file io.ReadSeeker) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", errs.NewInternalServerError(fmt.Errorf("failed to read uploaded file: %w", err))
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", errs.NewInternalServerError(fmt.Errorf("failed to rewind uploaded file: %w", err))
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	// ID3 タグのない MP3 はフレームの同期ワード（先頭11ビットが1）で判定する
+	if contentType == "application/octet-stream" && len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0 {
+		return "audio/mpeg", nil
+	}
+	return contentType, nil
+}
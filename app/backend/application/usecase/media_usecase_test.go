@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testPNG = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 16)...)
+	testMP3 = append([]byte{0xFF, 0xFB, 0x90, 0x64}, make([]byte, 16)...)
+)
+
+func TestMediaUseCase_UploadMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockMediaStorage := mock_service.NewMockIMediaStorage(ctrl)
+	mockMediaSigner := mock_service.NewMockIMediaSigner(ctrl)
+	usecase := &MediaUseCase{
+		categoryRepo: mockCategoryRepo,
+		mediaStorage: mockMediaStorage,
+		mediaSigner:  mockMediaSigner,
+		newID:        func() string { return "generated" },
+	}
+
+	expectCategory := func(id string) {
+		mockCategoryRepo.EXPECT().
+			GetCategoryByIDToData(gomock.Any(), id).
+			Return(model.NewCategory(id, id, "", ""), nil).
+			Times(1)
+	}
+	// 保存されるファイルが先頭から読めること（MIMEタイプの判定で読み進めたままにしない）
+	expectPut := func(key, contentType string, body []byte) {
+		mockMediaStorage.EXPECT().
+			Put(gomock.Any(), key, gomock.Any(), contentType).
+			DoAndReturn(func(_ interface{}, _ string, r io.ReadSeeker, _ string) error {
+				saved, err := io.ReadAll(r)
+				assert.NoError(t, err)
+				assert.Equal(t, body, saved)
+				return nil
+			}).
+			Times(1)
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		body     []byte
+		size     int64
+		setup    func()
+		wantErr  bool
+		errType  string
+		want     *dto.MediaUploadResponse
+	}{
+		{
+			name:     "正常系_画像",
+			category: "flags",
+			body:     testPNG,
+			setup: func() {
+				expectCategory("flags")
+				expectPut("images/flags/generated.png", "image/png", testPNG)
+				mockMediaSigner.EXPECT().
+					SignURL(gomock.Any(), "images/flags/generated.png").
+					Return("https://signed.example.com/images/flags/generated.png", nil).
+					Times(1)
+			},
+			want: &dto.MediaUploadResponse{
+				Key:         "images/flags/generated.png",
+				URL:         "https://signed.example.com/images/flags/generated.png",
+				ContentType: "image/png",
+				Size:        int64(len(testPNG)),
+			},
+		},
+		{
+			name:     "正常系_ID3タグのないMP3",
+			category: "animals",
+			body:     testMP3,
+			setup: func() {
+				expectCategory("animals")
+				expectPut("audio/animals/generated.mp3", "audio/mpeg", testMP3)
+				mockMediaSigner.EXPECT().
+					SignURL(gomock.Any(), "audio/animals/generated.mp3").
+					Return("https://signed.example.com/audio/animals/generated.mp3", nil).
+					Times(1)
+			},
+			want: &dto.MediaUploadResponse{
+				Key:         "audio/animals/generated.mp3",
+				URL:         "https://signed.example.com/audio/animals/generated.mp3",
+				ContentType: "audio/mpeg",
+				Size:        int64(len(testMP3)),
+			},
+		},
+		{
+			name:     "異常系_許可されていないファイル",
+			category: "flags",
+			body:     []byte("<html><script>alert(1)</script></html>"),
+			setup:    func() {},
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+		{
+			name:     "異常系_サイズ超過",
+			category: "flags",
+			body:     testPNG,
+			size:     model.MaxImageSize + 1,
+			setup:    func() {},
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+		{
+			name:     "異常系_存在しないカテゴリ",
+			category: "instruments",
+			body:     testPNG,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "instruments").
+					Return(nil, errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:     "異常系_保存エラー",
+			category: "flags",
+			body:     testPNG,
+			setup: func() {
+				expectCategory("flags")
+				mockMediaStorage.EXPECT().
+					Put(gomock.Any(), "images/flags/generated.png", gomock.Any(), "image/png").
+					Return(errs.NewInternalServerError(errors.New("put error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			size := tt.size
+			if size == 0 {
+				size = int64(len(tt.body))
+			}
+			req := &dto.MediaUploadRequest{Category: tt.category, File: bytes.NewReader(tt.body), Size: size}
+
+			result, err := usecase.UploadMedia(contextWithRole(model.RoleTeacher), req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); assert.True(t, ok) {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type IProgressUseCase interface {
+	// RecordAnswer 採点結果を context のユーザーの学習履歴に記録する（匿名アクセスの場合は何もしない）
+	RecordAnswer(ctx context.Context, result *model.AnswerResult) error
+	GetProgress(ctx context.Context) ([]*model.CategoryProgress, error)
+	GetCategoryProgress(ctx context.Context, category string) (*model.CategoryProgressDetail, error)
+	// GetReviewQueue context のユーザーのカテゴリ内の復習対象を返す（未ログインの場合は EC004）
+	GetReviewQueue(ctx context.Context, category string) (*model.ReviewQueue, error)
+}
+
+type ProgressUseCase struct {
+	progressRepo repository.IProgressRepository
+	categoryRepo repository.ICategoryRepository
+	quizRepo     repository.IQuizRepository
+	now          func() time.Time
+}
+
+func NewProgressUseCase(progressRepo repository.IProgressRepository, categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) IProgressUseCase {
+	return &ProgressUseCase{
+		progressRepo: progressRepo,
+		categoryRepo: categoryRepo,
+		quizRepo:     quizRepo,
+		now:          time.Now,
+	}
+}
+
+func (uc *ProgressUseCase) RecordAnswer(ctx context.Context, result *model.AnswerResult) error {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	progress, err := uc.progressRepo.GetQuizProgressToData(ctx, principal.UserID, result.Category, result.QuizID)
+	if err != nil {
+		if !errs.IsNotFound(err) {
+			return err
+		}
+		progress = model.NewQuizProgress(principal.UserID, result.Category, result.QuizID)
+	}
+
+	// 回答数などの回数は保存時に加算されるため、ここで読み取った値は復習スケジュールの計算にのみ使われる
+	answeredAt := uc.now()
+	progress.Record(result.IsCorrect, answeredAt)
+	record := model.NewAnswerRecord(principal.UserID, result, answeredAt)
+
+	return uc.progressRepo.SaveAnswer(ctx, record, progress)
+}
+
+func (uc *ProgressUseCase) GetProgress(ctx context.Context) ([]*model.CategoryProgress, error) {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errs.NewUnauthorizedError("authentication is required")
+	}
+
+	categories, err := uc.categoryRepo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	progresses, err := uc.progressRepo.GetQuizProgressesToData(ctx, principal.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := uc.quizRepo.CountQuizzesPerCategoryToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[string][]*model.QuizProgress)
+	for _, progress := range progresses {
+		byCategory[progress.Category] = append(byCategory[progress.Category], progress)
+	}
+
+	// 削除済みのカテゴリの履歴は集計に含めない
+	results := make([]*model.CategoryProgress, 0, len(categories))
+	for _, category := range categories {
+		results = append(results, model.NewCategoryProgress(category.ID, totals[category.ID], byCategory[category.ID]))
+	}
+
+	return results, nil
+}
+
+func (uc *ProgressUseCase) GetCategoryProgress(ctx context.Context, category string) (*model.CategoryProgressDetail, error) {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errs.NewUnauthorizedError("authentication is required")
+	}
+	if category == "" {
+		return nil, errs.NewBadRequestError("category is required")
+	}
+
+	if _, err := uc.categoryRepo.GetCategoryByIDToData(ctx, category); err != nil {
+		return nil, err
+	}
+
+	total, err := uc.quizRepo.CountQuizzesByCategoryToData(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	progresses, err := uc.progressRepo.GetQuizProgressesToData(ctx, principal.UserID, category)
+	if err != nil {
+		return nil, err
+	}
+	model.SortQuizProgresses(progresses)
+
+	return &model.CategoryProgressDetail{
+		Summary: model.NewCategoryProgress(category, total, progresses),
+		Quizzes: progresses,
+	}, nil
+}
+
+func (uc *ProgressUseCase) GetReviewQueue(ctx context.Context, category string) (*model.ReviewQueue, error) {
+	principal, ok := authctx.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, errs.NewUnauthorizedError("authentication is required for review mode")
+	}
+
+	progresses, err := uc.progressRepo.GetQuizProgressesToData(ctx, principal.UserID, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewReviewQueue(progresses, uc.now()), nil
+}
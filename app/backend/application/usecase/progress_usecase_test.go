@@ -0,0 +1,380 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestProgressUseCase(progressRepo *mock_repository.MockIProgressRepository, categoryRepo *mock_repository.MockICategoryRepository, quizRepo *mock_repository.MockIQuizRepository, now time.Time) *ProgressUseCase {
+	return &ProgressUseCase{
+		progressRepo: progressRepo,
+		categoryRepo: categoryRepo,
+		quizRepo:     quizRepo,
+		now:          func() time.Time { return now },
+	}
+}
+
+func TestProgressUseCase_RecordAnswer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProgressRepo := mock_repository.NewMockIProgressRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	usecase := newTestProgressUseCase(mockProgressRepo, mockCategoryRepo, mockQuizRepo, now)
+
+	quiz := model.NewQuiz("quiz_001", "/images/flags/it.png", "/audio/flags/it.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	correct := model.NewAnswerResult(quiz, "イタリア")
+	incorrect := model.NewAnswerResult(quiz, "フランス")
+	learnerCtx := contextWithRole(model.RoleLearner)
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		result       *model.AnswerResult
+		setup        func()
+		wantErr      bool
+		errType      string
+		wantAttempts int
+		wantStreak   int
+	}{
+		{
+			name:    "正常系_匿名アクセスは記録しない",
+			ctx:     context.Background(),
+			result:  correct,
+			setup:   func() {},
+			wantErr: false,
+		},
+		{
+			name:   "正常系_初回の回答",
+			ctx:    learnerCtx,
+			result: correct,
+			setup: func() {
+				mockProgressRepo.EXPECT().
+					GetQuizProgressToData(gomock.Any(), "user_learner", "flags", "quiz_001").
+					Return(nil, errs.NewNotFoundError("progress not found")).
+					Times(1)
+			},
+			wantErr:      false,
+			wantAttempts: 1,
+			wantStreak:   1,
+		},
+		{
+			name:   "正常系_連続正解",
+			ctx:    learnerCtx,
+			result: correct,
+			setup: func() {
+				progress := model.NewQuizProgress("user_learner", "flags", "quiz_001")
+				progress.Record(true, now.Add(-time.Hour))
+				progress.Record(true, now.Add(-time.Minute))
+				mockProgressRepo.EXPECT().
+					GetQuizProgressToData(gomock.Any(), "user_learner", "flags", "quiz_001").
+					Return(progress, nil).
+					Times(1)
+			},
+			wantErr:      false,
+			wantAttempts: 3,
+			wantStreak:   3,
+		},
+		{
+			name:   "正常系_不正解で連続正解数がリセットされる",
+			ctx:    learnerCtx,
+			result: incorrect,
+			setup: func() {
+				progress := model.NewQuizProgress("user_learner", "flags", "quiz_001")
+				progress.Record(true, now.Add(-time.Hour))
+				mockProgressRepo.EXPECT().
+					GetQuizProgressToData(gomock.Any(), "user_learner", "flags", "quiz_001").
+					Return(progress, nil).
+					Times(1)
+			},
+			wantErr:      false,
+			wantAttempts: 2,
+			wantStreak:   0,
+		},
+		{
+			name:   "異常系_習熟状況の取得失敗",
+			ctx:    learnerCtx,
+			result: correct,
+			setup: func() {
+				mockProgressRepo.EXPECT().
+					GetQuizProgressToData(gomock.Any(), "user_learner", "flags", "quiz_001").
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			var saved *model.QuizProgress
+			var record *model.AnswerRecord
+			if tt.wantAttempts > 0 {
+				mockProgressRepo.EXPECT().
+					SaveAnswer(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r *model.AnswerRecord, p *model.QuizProgress) error {
+						record = r
+						saved = p
+						return nil
+					}).
+					Times(1)
+			}
+
+			err := usecase.RecordAnswer(tt.ctx, tt.result)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.wantAttempts > 0 {
+				assert.Equal(t, tt.wantAttempts, saved.Attempts)
+				assert.Equal(t, tt.wantStreak, saved.Streak)
+				assert.Equal(t, now, saved.LastAnsweredAt)
+				assert.Equal(t, "USER#user_learner", record.PK)
+				assert.Equal(t, "ANSWER#2025-07-04T13:00:00Z#quiz_001", record.SK)
+				assert.Equal(t, tt.result.IsCorrect, record.IsCorrect)
+			}
+		})
+	}
+}
+
+func TestProgressUseCase_GetProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProgressRepo := mock_repository.NewMockIProgressRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	usecase := newTestProgressUseCase(mockProgressRepo, mockCategoryRepo, mockQuizRepo, now)
+
+	mastered := model.NewQuizProgress("user_learner", "flags", "quiz_001")
+	for i := 0; i < model.MasteryStreak; i++ {
+		mastered.Record(true, now)
+	}
+	answered := model.NewQuizProgress("user_learner", "flags", "quiz_002")
+	answered.Record(false, now.Add(-time.Hour))
+	deleted := model.NewQuizProgress("user_learner", "removed", "quiz_900")
+	deleted.Record(true, now)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr bool
+		errType string
+		want    []*model.CategoryProgress
+	}{
+		{
+			name: "正常系",
+			ctx:  contextWithRole(model.RoleLearner),
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoriesToData(gomock.Any()).
+					Return([]*model.Category{
+						model.NewCategory("flags", "国旗", "", ""),
+						model.NewCategory("animals", "動物", "", ""),
+					}, nil).
+					Times(1)
+				mockProgressRepo.EXPECT().
+					GetQuizProgressesToData(gomock.Any(), "user_learner", "").
+					Return([]*model.QuizProgress{mastered, answered, deleted}, nil).
+					Times(1)
+				// 削除済みのカテゴリに残ったクイズ数は集計に含めない
+				mockQuizRepo.EXPECT().
+					CountQuizzesPerCategoryToData(gomock.Any()).
+					Return(map[string]int{"flags": 10, "animals": 5, "removed": 2}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			want: []*model.CategoryProgress{
+				{
+					Category:        "flags",
+					TotalQuizzes:    10,
+					AnsweredQuizzes: 2,
+					MasteredQuizzes: 1,
+					Attempts:        4,
+					CorrectCount:    3,
+					LastAnsweredAt:  &now,
+				},
+				{
+					Category:     "animals",
+					TotalQuizzes: 5,
+				},
+			},
+		},
+		{
+			name: "正常系_クイズのないカテゴリ",
+			ctx:  contextWithRole(model.RoleLearner),
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoriesToData(gomock.Any()).
+					Return([]*model.Category{model.NewCategory("animals", "動物", "", "")}, nil).
+					Times(1)
+				mockProgressRepo.EXPECT().
+					GetQuizProgressesToData(gomock.Any(), "user_learner", "").
+					Return([]*model.QuizProgress{}, nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					CountQuizzesPerCategoryToData(gomock.Any()).
+					Return(map[string]int{}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			want: []*model.CategoryProgress{
+				{Category: "animals"},
+			},
+		},
+		{
+			name: "異常系_クイズ数の取得失敗",
+			ctx:  contextWithRole(model.RoleLearner),
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoriesToData(gomock.Any()).
+					Return([]*model.Category{model.NewCategory("flags", "国旗", "", "")}, nil).
+					Times(1)
+				mockProgressRepo.EXPECT().
+					GetQuizProgressesToData(gomock.Any(), "user_learner", "").
+					Return([]*model.QuizProgress{}, nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					CountQuizzesPerCategoryToData(gomock.Any()).
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+		{
+			name:    "異常系_未ログイン",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			progresses, err := usecase.GetProgress(tt.ctx)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, progresses)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, progresses)
+			}
+		})
+	}
+}
+
+func TestProgressUseCase_GetCategoryProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProgressRepo := mock_repository.NewMockIProgressRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	usecase := newTestProgressUseCase(mockProgressRepo, mockCategoryRepo, mockQuizRepo, now)
+
+	older := model.NewQuizProgress("user_learner", "flags", "quiz_001")
+	older.Record(true, now.Add(-time.Hour))
+	newer := model.NewQuizProgress("user_learner", "flags", "quiz_002")
+	newer.Record(false, now)
+
+	tests := []struct {
+		name        string
+		category    string
+		setup       func()
+		wantErr     bool
+		errType     string
+		wantQuizIDs []string
+	}{
+		{
+			name:     "正常系_直近の回答順",
+			category: "flags",
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
+				mockQuizRepo.EXPECT().CountQuizzesByCategoryToData(gomock.Any(), "flags").Return(10, nil).Times(1)
+				mockProgressRepo.EXPECT().
+					GetQuizProgressesToData(gomock.Any(), "user_learner", "flags").
+					Return([]*model.QuizProgress{older, newer}, nil).
+					Times(1)
+			},
+			wantErr:     false,
+			wantQuizIDs: []string{"quiz_002", "quiz_001"},
+		},
+		{
+			name:     "異常系_カテゴリが見つからない",
+			category: "nonexistent",
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			detail, err := usecase.GetCategoryProgress(contextWithRole(model.RoleLearner), tt.category)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, detail)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 10, detail.Summary.TotalQuizzes)
+				assert.Equal(t, 2, detail.Summary.AnsweredQuizzes)
+				quizIDs := make([]string, 0, len(detail.Quizzes))
+				for _, progress := range detail.Quizzes {
+					quizIDs = append(quizIDs, progress.QuizID)
+				}
+				assert.Equal(t, tt.wantQuizIDs, quizIDs)
+			}
+		})
+	}
+}
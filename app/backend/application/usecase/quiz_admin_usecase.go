@@ -0,0 +1,218 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type IQuizAdminUseCase interface {
+	CreateQuiz(ctx context.Context, req *dto.QuizRequest) (*model.Quiz, error)
+	UpdateQuiz(ctx context.Context, id string, req *dto.QuizRequest) (*model.Quiz, error)
+	PatchQuiz(ctx context.Context, id string, req *dto.PatchQuizRequest) (*model.Quiz, error)
+	DeleteQuiz(ctx context.Context, id string) error
+}
+
+type QuizAdminUseCase struct {
+	quizRepo     repository.IQuizRepository
+	categoryRepo repository.ICategoryRepository
+	now          func() time.Time
+	newID        func() string
+}
+
+func NewQuizAdminUseCase(quizRepo repository.IQuizRepository, categoryRepo repository.ICategoryRepository) IQuizAdminUseCase {
+	return &QuizAdminUseCase{
+		quizRepo:     quizRepo,
+		categoryRepo: categoryRepo,
+		now:          time.Now,
+		newID:        func() string { return "quiz_" + idgen.New() },
+	}
+}
+
+func (uc *QuizAdminUseCase) CreateQuiz(ctx context.Context, req *dto.QuizRequest) (*model.Quiz, error) {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return nil, err
+	}
+
+	id := req.ID
+	if id == "" {
+		id = uc.newID()
+	}
+
+	quiz := model.NewQuiz(id, req.QuestionImageURL, req.QuestionAudioURL, req.CorrectAnswer, req.Choices, req.Category, req.Explanation)
+	quiz.GenerateDistractors = req.GenerateDistractors
+	quiz.Translations = req.Translations
+	quiz.AudioAssets = req.AudioAssets
+	now := uc.now()
+	quiz.CreatedAt = now
+	quiz.UpdatedAt = now
+
+	if err := uc.validateQuiz(ctx, quiz); err != nil {
+		return nil, err
+	}
+
+	// IDはカテゴリをまたいで一意とする
+	if _, err := uc.quizRepo.GetQuizByIDToData(ctx, id); err == nil {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("quiz with id '%s' already exists", id))
+	} else if !errs.IsNotFound(err) {
+		return nil, err
+	}
+
+	if err := uc.quizRepo.Create(ctx, quiz); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("quiz created", "quizId", quiz.ID, "category", quiz.Category)
+
+	return quiz, nil
+}
+
+func (uc *QuizAdminUseCase) UpdateQuiz(ctx context.Context, id string, req *dto.QuizRequest) (*model.Quiz, error) {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errs.NewBadRequestError("quiz id is required")
+	}
+	if req.ID != "" && req.ID != id {
+		return nil, errs.NewBadRequestError("quiz id cannot be changed")
+	}
+
+	current, err := uc.quizRepo.GetQuizByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	quiz := model.NewQuiz(id, req.QuestionImageURL, req.QuestionAudioURL, req.CorrectAnswer, req.Choices, req.Category, req.Explanation)
+	quiz.GenerateDistractors = req.GenerateDistractors
+	quiz.Translations = req.Translations
+	quiz.AudioAssets = req.AudioAssets
+	return uc.save(ctx, current, quiz)
+}
+
+func (uc *QuizAdminUseCase) PatchQuiz(ctx context.Context, id string, req *dto.PatchQuizRequest) (*model.Quiz, error) {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errs.NewBadRequestError("quiz id is required")
+	}
+
+	current, err := uc.quizRepo.GetQuizByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	quiz := model.NewQuiz(
+		id,
+		stringOrDefault(req.QuestionImageURL, current.QuestionImageURL),
+		stringOrDefault(req.QuestionAudioURL, current.QuestionAudioURL),
+		stringOrDefault(req.CorrectAnswer, current.CorrectAnswer),
+		current.Choices,
+		stringOrDefault(req.Category, current.Category),
+		stringOrDefault(req.Explanation, current.Explanation),
+	)
+	if req.Choices != nil {
+		quiz.Choices = *req.Choices
+	}
+	quiz.GenerateDistractors = current.GenerateDistractors
+	if req.GenerateDistractors != nil {
+		quiz.GenerateDistractors = *req.GenerateDistractors
+	}
+	quiz.Translations = current.Translations
+	if req.Translations != nil {
+		quiz.Translations = *req.Translations
+	}
+	quiz.AudioAssets = current.AudioAssets
+	if req.AudioAssets != nil {
+		quiz.AudioAssets = *req.AudioAssets
+	}
+
+	return uc.save(ctx, current, quiz)
+}
+
+func (uc *QuizAdminUseCase) DeleteQuiz(ctx context.Context, id string) error {
+	if err := authorize(ctx, model.PermissionWriteQuizzes); err != nil {
+		return err
+	}
+
+	if id == "" {
+		return errs.NewBadRequestError("quiz id is required")
+	}
+
+	if err := uc.quizRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	logging.FromContext(ctx).Info("quiz deleted", "quizId", id)
+
+	return nil
+}
+
+// save 作成日時を引き継ぎ、更新日時を更新して保存する
+// カテゴリが変わった場合のパーティション移動はリポジトリ側で行う
+func (uc *QuizAdminUseCase) save(ctx context.Context, current, quiz *model.Quiz) (*model.Quiz, error) {
+	quiz.CreatedAt = current.CreatedAt
+	quiz.UpdatedAt = uc.now()
+
+	if err := uc.validateQuiz(ctx, quiz); err != nil {
+		return nil, err
+	}
+
+	if err := uc.quizRepo.Update(ctx, quiz); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("quiz updated", "quizId", quiz.ID, "category", quiz.Category)
+
+	return quiz, nil
+}
+
+// validateQuiz 保存前のクイズの入力チェック
+func (uc *QuizAdminUseCase) validateQuiz(ctx context.Context, quiz *model.Quiz) error {
+	if err := validateQuizFields(quiz); err != nil {
+		return err
+	}
+
+	if _, err := uc.categoryRepo.GetCategoryByIDToData(ctx, quiz.Category); err != nil {
+		if errs.IsNotFound(err) {
+			return errs.NewBadRequestError(fmt.Sprintf("category '%s' does not exist", quiz.Category))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// validateQuizFields 他のリソースに依存しないクイズ項目のチェック
+func validateQuizFields(quiz *model.Quiz) error {
+	fieldErrs := quiz.Validate()
+	if fieldErrs == nil {
+		return nil
+	}
+	return newValidationError(fieldErrs)
+}
+
+// newValidationError モデルの項目エラーを EC001 エラーに変換する
+func newValidationError(fieldErrs model.FieldErrors) *errs.AppError {
+	fields := make([]errs.FieldError, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		fields = append(fields, errs.FieldError{Field: fieldErr.Field, Message: fieldErr.Message})
+	}
+	return errs.NewValidationError(fieldErrs.Error(), fields)
+}
+
+func stringOrDefault(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
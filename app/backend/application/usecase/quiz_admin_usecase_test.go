@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var adminTestNow = time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+func newTestQuizAdminUseCase(quizRepo *mock_repository.MockIQuizRepository, categoryRepo *mock_repository.MockICategoryRepository) *QuizAdminUseCase {
+	return &QuizAdminUseCase{
+		quizRepo:     quizRepo,
+		categoryRepo: categoryRepo,
+		now:          func() time.Time { return adminTestNow },
+		newID:        func() string { return "quiz_generated" },
+	}
+}
+
+func newTestQuizRequest() *dto.QuizRequest {
+	return &dto.QuizRequest{
+		ID:               "quiz_flag_011",
+		QuestionImageURL: "https://example.com/flags/fr.png",
+		QuestionAudioURL: "https://example.com/audio/fr.mp3",
+		CorrectAnswer:    "フランス",
+		Choices:          []string{"フランス", "イタリア", "ドイツ", "スペイン"},
+		Category:         "flags",
+		Explanation:      "フランスの国旗は青、白、赤の三色旗です。",
+	}
+}
+
+func TestQuizAdminUseCase_CreateQuiz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	usecase := newTestQuizAdminUseCase(mockQuizRepo, mockCategoryRepo)
+
+	tests := []struct {
+		name    string
+		req     func() *dto.QuizRequest
+		setup   func()
+		wantErr bool
+		errType string
+		wantID  string
+	}{
+		{
+			name: "正常系",
+			req:  newTestQuizRequest,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_flag_011").
+					Return(nil, errs.NewNotFoundError("quiz not found")).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantID:  "quiz_flag_011",
+		},
+		{
+			name: "正常系_ID自動採番",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.ID = ""
+				return req
+			},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_generated").
+					Return(nil, errs.NewNotFoundError("quiz not found")).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantID:  "quiz_generated",
+		},
+		{
+			name: "異常系_正解が選択肢に含まれない",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.CorrectAnswer = "日本"
+				return req
+			},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_選択肢不足",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.Choices = []string{"フランス"}
+				return req
+			},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_存在しないカテゴリ",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.Category = "unknown"
+				return req
+			},
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "unknown").
+					Return(nil, errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_ID重複",
+			req:  newTestQuizRequest,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_flag_011").
+					Return(model.NewQuiz("quiz_flag_011", "url", "audio", "answer", []string{"answer"}, "animals", ""), nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			quiz, err := usecase.CreateQuiz(contextWithRole(model.RoleTeacher), tt.req())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, quiz)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantID, quiz.ID)
+				assert.Equal(t, "CATEGORY#flags", quiz.PK)
+				assert.Equal(t, "QUIZ#"+tt.wantID, quiz.SK)
+				assert.Equal(t, adminTestNow, quiz.CreatedAt)
+				assert.Equal(t, adminTestNow, quiz.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestQuizAdminUseCase_UpdateQuiz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	usecase := newTestQuizAdminUseCase(mockQuizRepo, mockCategoryRepo)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := model.NewQuiz("quiz_flag_011", "/images/flags/fr.png", "/audio/flags/fr.mp3", "フランス", []string{"フランス", "イタリア"}, "flags", "")
+	current.CreatedAt = createdAt
+
+	tests := []struct {
+		name    string
+		id      string
+		req     func() *dto.QuizRequest
+		setup   func()
+		wantErr bool
+		errType string
+		wantPK  string
+	}{
+		{
+			name: "正常系_カテゴリ変更でパーティション移動",
+			id:   "quiz_flag_011",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.Category = "words"
+				return req
+			},
+			setup: func() {
+				mockQuizRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_flag_011").
+					Return(current, nil).
+					Times(1)
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "words").
+					Return(model.NewCategory("words", "言葉", "", ""), nil).
+					Times(1)
+				mockQuizRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantPK:  "CATEGORY#words",
+		},
+		{
+			name: "異常系_IDの変更",
+			id:   "quiz_flag_011",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.ID = "quiz_flag_999"
+				return req
+			},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_クイズが見つからない",
+			id:   "nonexistent",
+			req: func() *dto.QuizRequest {
+				req := newTestQuizRequest()
+				req.ID = ""
+				return req
+			},
+			setup: func() {
+				mockQuizRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("quiz not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			quiz, err := usecase.UpdateQuiz(contextWithRole(model.RoleTeacher), tt.id, tt.req())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, quiz)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantPK, quiz.PK)
+				assert.Equal(t, createdAt, quiz.CreatedAt)
+				assert.Equal(t, adminTestNow, quiz.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestQuizAdminUseCase_PatchQuiz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	usecase := newTestQuizAdminUseCase(mockQuizRepo, mockCategoryRepo)
+
+	explanation := "新しい解説"
+	current := model.NewQuiz("quiz_flag_011", "/images/flags/fr.png", "/audio/flags/fr.mp3", "フランス", []string{"フランス", "イタリア"}, "flags", "古い解説")
+
+	mockQuizRepo.EXPECT().
+		GetQuizByIDToData(gomock.Any(), "quiz_flag_011").
+		Return(current, nil).
+		Times(1)
+	mockCategoryRepo.EXPECT().
+		GetCategoryByIDToData(gomock.Any(), "flags").
+		Return(model.NewCategory("flags", "国旗", "", ""), nil).
+		Times(1)
+	mockQuizRepo.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	quiz, err := usecase.PatchQuiz(contextWithRole(model.RoleTeacher), "quiz_flag_011", &dto.PatchQuizRequest{Explanation: &explanation})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "新しい解説", quiz.Explanation)
+	// 指定されなかった項目は維持される
+	assert.Equal(t, "フランス", quiz.CorrectAnswer)
+	assert.Equal(t, []string{"フランス", "イタリア"}, quiz.Choices)
+	assert.Equal(t, "CATEGORY#flags", quiz.PK)
+	assert.Equal(t, adminTestNow, quiz.UpdatedAt)
+}
+
+func TestQuizAdminUseCase_DeleteQuiz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	usecase := newTestQuizAdminUseCase(mockQuizRepo, mockCategoryRepo)
+
+	tests := []struct {
+		name    string
+		id      string
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			id:   "quiz_flag_011",
+			setup: func() {
+				mockQuizRepo.EXPECT().
+					Delete(gomock.Any(), "quiz_flag_011").
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_空のID",
+			id:      "",
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_リポジトリエラー",
+			id:   "quiz_flag_011",
+			setup: func() {
+				mockQuizRepo.EXPECT().
+					Delete(gomock.Any(), "quiz_flag_011").
+					Return(errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := usecase.DeleteQuiz(contextWithRole(model.RoleTeacher), tt.id)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
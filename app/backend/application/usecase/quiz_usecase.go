@@ -4,51 +4,200 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
 
 	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
 	"audio-slide-app/domain/model"
 	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
 )
 
 type IQuizUseCase interface {
-	GetQuizzesByCategory(ctx context.Context, category string, count int) ([]*model.Quiz, error)
-	GetQuizByID(ctx context.Context, id string) (*model.Quiz, error)
+	// 出題用のメソッドは選択肢を並べ替えて返す。choiceCount は選択肢数（0 はクイズごとのデフォルト）
+	GetQuizzesByCategory(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error)
+	// GetReviewQuizzes 復習の期日を迎えた問題を優先し、残りを未回答の問題で埋めて返す
+	GetReviewQuizzes(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error)
+	GetQuizByID(ctx context.Context, id string, choiceCount int) (*model.Quiz, error)
+	// SubmitAnswer 採点し、ログイン中のユーザーの場合は学習履歴に記録する
+	SubmitAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error)
+	// GradeAnswer 採点のみを行う（学習履歴は呼び出し側で記録する）
+	GradeAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error)
 }
 
 type QuizUseCase struct {
-	quizRepo repository.IQuizRepository
+	quizRepo        repository.IQuizRepository
+	categoryRepo    repository.ICategoryRepository
+	progressUseCase IProgressUseCase
+	mediaSigner     service.IMediaSigner
+	shuffle         model.ShuffleFunc
 }
 
-func NewQuizUseCase(quizRepo repository.IQuizRepository) IQuizUseCase {
+func NewQuizUseCase(quizRepo repository.IQuizRepository, categoryRepo repository.ICategoryRepository, progressUseCase IProgressUseCase, mediaSigner service.IMediaSigner) IQuizUseCase {
 	return &QuizUseCase{
-		quizRepo: quizRepo,
+		quizRepo:        quizRepo,
+		categoryRepo:    categoryRepo,
+		progressUseCase: progressUseCase,
+		mediaSigner:     mediaSigner,
+		shuffle:         rand.Shuffle,
 	}
 }
 
-func (uc *QuizUseCase) GetQuizzesByCategory(ctx context.Context, category string, count int) ([]*model.Quiz, error) {
+func (uc *QuizUseCase) GetQuizzesByCategory(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
 	// カテゴリのバリデーション
-	validCategories := map[string]bool{
-		"flags":   true,
-		"animals": true,
-		"words":   true,
+	if err := uc.validateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	if err := validateChoiceCount(choiceCount); err != nil {
+		return nil, err
 	}
 
-	if !validCategories[category] {
-		return nil, errs.NewBadRequestError("invalid category specified")
+	quizzes, err := uc.quizRepo.GetQuizzesByCategoryToData(ctx, category, normalizeQuizCount(count))
+	if err != nil {
+		return nil, err
 	}
 
-	// カウントのバリデーション
-	if count < 1 || count > 50 {
-		count = 10 // デフォルト値
+	return presentQuizzes(ctx, uc.quizRepo, uc.mediaSigner, quizzes, choiceCount, uc.shuffle)
+}
+
+func (uc *QuizUseCase) GetReviewQuizzes(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
+	if err := uc.validateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	if err := validateChoiceCount(choiceCount); err != nil {
+		return nil, err
+	}
+	count = normalizeQuizCount(count)
+
+	queue, err := uc.progressUseCase.GetReviewQueue(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	// 読み取る件数を学習履歴の量によらず出題数までに抑える
+	// 期日を迎えた問題は期日の古い順に出題数分だけまとめて取得する（回答後に削除・移動された問題は含まれない）
+	dueIDs := queue.DueQuizIDs
+	if len(dueIDs) > count {
+		dueIDs = dueIDs[:count]
+	}
+	quizzes := make([]*model.Quiz, 0, count)
+	if len(dueIDs) > 0 {
+		due, err := uc.quizRepo.GetQuizzesByIDsToData(ctx, category, dueIDs)
+		if err != nil {
+			return nil, err
+		}
+		quizzes = append(quizzes, due...)
+	}
+
+	if len(quizzes) >= count {
+		return presentQuizzes(ctx, uc.quizRepo, uc.mediaSigner, quizzes, choiceCount, uc.shuffle)
 	}
 
-	return uc.quizRepo.GetQuizzesByCategoryToData(ctx, category, count)
+	// 残りはランダムに出題数分を取得し、回答済みの問題を除いて埋める（回答済みが多い場合は出題数より少なくなる）
+	candidates, err := uc.quizRepo.GetQuizzesByCategoryToData(ctx, category, count)
+	if err != nil {
+		return nil, err
+	}
+	for _, quiz := range candidates {
+		if len(quizzes) >= count {
+			break
+		}
+		if queue.Answered[quiz.ID] {
+			continue
+		}
+		quizzes = append(quizzes, quiz)
+	}
+
+	return presentQuizzes(ctx, uc.quizRepo, uc.mediaSigner, quizzes, choiceCount, uc.shuffle)
 }
 
-func (uc *QuizUseCase) GetQuizByID(ctx context.Context, id string) (*model.Quiz, error) {
+func (uc *QuizUseCase) GetQuizByID(ctx context.Context, id string, choiceCount int) (*model.Quiz, error) {
 	if id == "" {
 		return nil, errs.NewBadRequestError("quiz id is required")
 	}
+	if err := validateChoiceCount(choiceCount); err != nil {
+		return nil, err
+	}
+
+	quiz, err := uc.quizRepo.GetQuizByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	presented, err := presentQuizzes(ctx, uc.quizRepo, uc.mediaSigner, []*model.Quiz{quiz}, choiceCount, uc.shuffle)
+	if err != nil {
+		return nil, err
+	}
+	return presented[0], nil
+}
+
+func (uc *QuizUseCase) SubmitAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	result, err := uc.GradeAnswer(ctx, id, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	// ログイン中のユーザーの場合は学習履歴に記録する
+	if err := uc.progressUseCase.RecordAnswer(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *QuizUseCase) GradeAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	if id == "" {
+		return nil, errs.NewBadRequestError("quiz id is required")
+	}
+	if answer == "" {
+		return nil, errs.NewBadRequestError("answer is required")
+	}
+
+	stored, err := uc.quizRepo.GetQuizByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 出題時と同じ言語の文言で採点する
+	lang := stored.ContentLanguage(locale.FromContext(ctx))
+	quiz := stored.Localize(lang)
+
+	// 選択肢に存在しない回答は採点対象外
+	// 出題時に補った誤答は保存されないため、同じカテゴリの他のクイズの正解も受け付ける
+	if !quiz.HasChoice(answer) && !quiz.IsCorrect(answer) {
+		pool, err := uc.quizRepo.GetCorrectAnswersByCategoryToData(ctx, quiz.Category, lang)
+		if err != nil {
+			return nil, err
+		}
+		if !quiz.AcceptsAnswer(answer, pool) {
+			return nil, errs.NewBadRequestError(fmt.Sprintf("answer '%s' is not one of the choices", answer))
+		}
+	}
+
+	return model.NewAnswerResult(quiz, answer), nil
+}
+
+// normalizeQuizCount 出題数が範囲外の場合はデフォルト値にする
+func normalizeQuizCount(count int) int {
+	if count < 1 || count > 50 {
+		return 10 // デフォルト値
+	}
+	return count
+}
+
+// validateCategory 登録済みのカテゴリかを確認する
+func (uc *QuizUseCase) validateCategory(ctx context.Context, category string) error {
+	if category == "" {
+		return errs.NewBadRequestError("category is required")
+	}
+
+	if _, err := uc.categoryRepo.GetCategoryByIDToData(ctx, category); err != nil {
+		if errs.IsNotFound(err) {
+			return errs.NewBadRequestError("invalid category specified")
+		}
+		return err
+	}
 
-	return uc.quizRepo.GetQuizByIDToData(ctx, id)
+	return nil
 }
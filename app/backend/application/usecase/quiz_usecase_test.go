@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
 	"audio-slide-app/domain/model"
 	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+	mock_usecase "audio-slide-app/mocks/usecase"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -18,7 +21,10 @@ func TestQuizUseCase_GetQuizzesByCategory(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
-	usecase := NewQuizUseCase(mockRepo)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	mockMediaSigner := mock_service.NewMockIMediaSigner(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mockMediaSigner)
 
 	tests := []struct {
 		name     string
@@ -33,13 +39,26 @@ func TestQuizUseCase_GetQuizzesByCategory(t *testing.T) {
 			category: "flags",
 			count:    5,
 			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
 				quizzes := []*model.Quiz{
-					model.NewQuiz("quiz1", "url1", "audio1", "answer1", []string{"answer1", "wrong1"}, "flags", "explanation1"),
+					model.NewQuiz("quiz1", "images/flags/it.png", "audio/flags/it.mp3", "answer1", []string{"answer1", "wrong1"}, "flags", "explanation1"),
 				}
 				mockRepo.EXPECT().
 					GetQuizzesByCategoryToData(gomock.Any(), "flags", 5).
 					Return(quizzes, nil).
 					Times(1)
+				// オブジェクトキーで保存された画像・音声は署名付き URL に差し替える
+				mockMediaSigner.EXPECT().
+					SignURL(gomock.Any(), "images/flags/it.png").
+					Return("https://signed.example.com/images/flags/it.png", nil).
+					Times(1)
+				mockMediaSigner.EXPECT().
+					SignURL(gomock.Any(), "audio/flags/it.mp3").
+					Return("https://signed.example.com/audio/flags/it.mp3", nil).
+					Times(1)
 			},
 			wantErr: false,
 		},
@@ -47,15 +66,61 @@ func TestQuizUseCase_GetQuizzesByCategory(t *testing.T) {
 			name:     "異常系_無効なカテゴリ",
 			category: "invalid",
 			count:    5,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "invalid").
+					Return(nil, errs.NewNotFoundError("category not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:     "正常系_データ追加されたカテゴリ",
+			category: "instruments",
+			count:    5,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "instruments").
+					Return(model.NewCategory("instruments", "楽器", "", ""), nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetQuizzesByCategoryToData(gomock.Any(), "instruments", 5).
+					Return([]*model.Quiz{}, nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "異常系_空のカテゴリ",
+			category: "",
+			count:    5,
 			setup:    func() {},
 			wantErr:  true,
 			errType:  errs.EC001,
 		},
+		{
+			name:     "異常系_カテゴリ取得エラー",
+			category: "flags",
+			count:    5,
+			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
 		{
 			name:     "正常系_カウント調整",
 			category: "animals",
 			count:    100, // 50を超える値
 			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "animals").
+					Return(model.NewCategory("animals", "動物", "", ""), nil).
+					Times(1)
 				quizzes := []*model.Quiz{}
 				mockRepo.EXPECT().
 					GetQuizzesByCategoryToData(gomock.Any(), "animals", 10). // デフォルト値に調整される
@@ -69,6 +134,10 @@ func TestQuizUseCase_GetQuizzesByCategory(t *testing.T) {
 			category: "flags",
 			count:    5,
 			setup: func() {
+				mockCategoryRepo.EXPECT().
+					GetCategoryByIDToData(gomock.Any(), "flags").
+					Return(model.NewCategory("flags", "国旗", "", ""), nil).
+					Times(1)
 				mockRepo.EXPECT().
 					GetQuizzesByCategoryToData(gomock.Any(), "flags", 5).
 					Return(nil, errors.New("database error")).
@@ -82,7 +151,7 @@ func TestQuizUseCase_GetQuizzesByCategory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			result, err := usecase.GetQuizzesByCategory(context.Background(), tt.category, tt.count)
+			result, err := usecase.GetQuizzesByCategory(context.Background(), tt.category, tt.count, 0)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -105,7 +174,9 @@ func TestQuizUseCase_GetQuizByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
-	usecase := NewQuizUseCase(mockRepo)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mock_service.NewMockIMediaSigner(ctrl))
 
 	tests := []struct {
 		name    string
@@ -118,7 +189,7 @@ func TestQuizUseCase_GetQuizByID(t *testing.T) {
 			name: "正常系",
 			id:   "quiz_001",
 			setup: func() {
-				quiz := model.NewQuiz("quiz_001", "url", "audio", "answer", []string{"answer"}, "flags", "explanation")
+				quiz := model.NewQuiz("quiz_001", "/images/flags/it.png", "/audio/flags/it.mp3", "answer", []string{"answer"}, "flags", "explanation")
 				mockRepo.EXPECT().
 					GetQuizByIDToData(gomock.Any(), "quiz_001").
 					Return(quiz, nil).
@@ -150,7 +221,7 @@ func TestQuizUseCase_GetQuizByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			result, err := usecase.GetQuizByID(context.Background(), tt.id)
+			result, err := usecase.GetQuizByID(context.Background(), tt.id, 0)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -167,3 +238,463 @@ func TestQuizUseCase_GetQuizByID(t *testing.T) {
 		})
 	}
 }
+
+func TestQuizUseCase_SubmitAnswer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mock_service.NewMockIMediaSigner(ctrl))
+
+	quiz := model.NewQuiz("quiz_001", "url", "audio", "イタリア", []string{"イタリア", "フランス"}, "flags", "explanation")
+
+	tests := []struct {
+		name        string
+		id          string
+		answer      string
+		setup       func()
+		wantErr     bool
+		errType     string
+		wantCorrect bool
+	}{
+		{
+			name:   "正常系_正解",
+			id:     "quiz_001",
+			answer: "イタリア",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_001").
+					Return(quiz, nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr:     false,
+			wantCorrect: true,
+		},
+		{
+			name:   "正常系_不正解",
+			id:     "quiz_001",
+			answer: "フランス",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_001").
+					Return(quiz, nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr:     false,
+			wantCorrect: false,
+		},
+		{
+			name:   "異常系_学習履歴の記録失敗",
+			id:     "quiz_001",
+			answer: "イタリア",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_001").
+					Return(quiz, nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), gomock.Any()).
+					Return(errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+		{
+			name:    "異常系_空のID",
+			id:      "",
+			answer:  "イタリア",
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_空の回答",
+			id:      "quiz_001",
+			answer:  "",
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:   "正常系_出題時に補った誤答",
+			id:     "quiz_001",
+			answer: "ドイツ",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_001").
+					Return(quiz, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return([]string{"イタリア", "ドイツ", "スペイン"}, nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr:     false,
+			wantCorrect: false,
+		},
+		{
+			name:   "異常系_選択肢に存在しない回答",
+			id:     "quiz_001",
+			answer: "日本",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "quiz_001").
+					Return(quiz, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return([]string{"イタリア", "ドイツ", "スペイン"}, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:   "異常系_クイズが見つからない",
+			id:     "nonexistent",
+			answer: "イタリア",
+			setup: func() {
+				mockRepo.EXPECT().
+					GetQuizByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("quiz not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.SubmitAnswer(context.Background(), tt.id, tt.answer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCorrect, result.IsCorrect)
+				assert.Equal(t, "イタリア", result.CorrectAnswer)
+				assert.Equal(t, "explanation", result.Explanation)
+			}
+		})
+	}
+}
+
+func TestQuizUseCase_GradeAnswer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	// 採点のみを行い、学習履歴は記録しない（RecordAnswer が呼ばれると失敗する）
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mock_service.NewMockIMediaSigner(ctrl))
+
+	quiz := model.NewQuiz("quiz_001", "url", "audio", "イタリア", []string{"イタリア", "フランス"}, "flags", "explanation")
+
+	tests := []struct {
+		name        string
+		answer      string
+		setup       func()
+		wantErr     bool
+		errType     string
+		wantCorrect bool
+	}{
+		{
+			name:   "正常系_正解",
+			answer: "イタリア",
+			setup: func() {
+				mockRepo.EXPECT().GetQuizByIDToData(gomock.Any(), "quiz_001").Return(quiz, nil).Times(1)
+			},
+			wantErr:     false,
+			wantCorrect: true,
+		},
+		{
+			name:   "異常系_選択肢に存在しない回答",
+			answer: "日本",
+			setup: func() {
+				mockRepo.EXPECT().GetQuizByIDToData(gomock.Any(), "quiz_001").Return(quiz, nil).Times(1)
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", "ja").
+					Return([]string{"イタリア"}, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.GradeAnswer(context.Background(), "quiz_001", tt.answer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCorrect, result.IsCorrect)
+				assert.Equal(t, "flags", result.Category)
+			}
+		})
+	}
+}
+
+func TestQuizUseCase_SubmitAnswer_Localized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mock_service.NewMockIMediaSigner(ctrl))
+
+	quiz := model.NewQuiz("quiz_001", "/images/flags/it.png", "/audio/flags/it.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", "解説")
+	quiz.Translations = map[string]model.QuizText{
+		locale.English: {CorrectAnswer: "Italy", Choices: []string{"Italy", "France"}},
+	}
+	ctx := locale.WithLanguage(context.Background(), locale.English)
+
+	tests := []struct {
+		name            string
+		answer          string
+		setup           func()
+		wantErr         bool
+		errType         string
+		wantCorrect     bool
+		wantAnswer      string
+		wantExplanation string
+	}{
+		{
+			name:   "正常系_英語の正解",
+			answer: "Italy",
+			setup: func() {
+				mockRepo.EXPECT().GetQuizByIDToData(gomock.Any(), "quiz_001").Return(quiz, nil).Times(1)
+				mockProgressUseCase.EXPECT().RecordAnswer(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			wantErr:         false,
+			wantCorrect:     true,
+			wantAnswer:      "Italy",
+			wantExplanation: "解説",
+		},
+		{
+			name:   "異常系_英語で出題したクイズへの日本語の回答",
+			answer: "イタリア",
+			setup: func() {
+				mockRepo.EXPECT().GetQuizByIDToData(gomock.Any(), "quiz_001").Return(quiz, nil).Times(1)
+				mockRepo.EXPECT().
+					GetCorrectAnswersByCategoryToData(gomock.Any(), "flags", locale.English).
+					Return([]string{"Italy", "Germany"}, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.SubmitAnswer(ctx, "quiz_001", tt.answer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCorrect, result.IsCorrect)
+				assert.Equal(t, tt.wantAnswer, result.CorrectAnswer)
+				assert.Equal(t, tt.wantExplanation, result.Explanation)
+			}
+		})
+	}
+}
+
+func TestQuizUseCase_GetReviewQuizzes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIQuizRepository(ctrl)
+	mockCategoryRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := NewQuizUseCase(mockRepo, mockCategoryRepo, mockProgressUseCase, mock_service.NewMockIMediaSigner(ctrl))
+
+	newQuiz := func(id string) *model.Quiz {
+		return model.NewQuiz(id, "/images/flags/it.png", "/audio/flags/it.mp3", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	}
+	category := model.NewCategory("flags", "国旗", "", "")
+
+	tests := []struct {
+		name    string
+		count   int
+		setup   func()
+		wantErr bool
+		errType string
+		wantIDs []string
+	}{
+		{
+			name:  "正常系_期日を迎えた問題を優先し未回答の問題で埋める",
+			count: 3,
+			setup: func() {
+				mockCategoryRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "flags").Return(category, nil).Times(1)
+				mockProgressUseCase.EXPECT().
+					GetReviewQueue(gomock.Any(), "flags").
+					Return(&model.ReviewQueue{
+						DueQuizIDs: []string{"quiz_002"},
+						Answered:   map[string]bool{"quiz_001": true, "quiz_002": true},
+					}, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetQuizzesByIDsToData(gomock.Any(), "flags", []string{"quiz_002"}).
+					Return([]*model.Quiz{newQuiz("quiz_002")}, nil).
+					Times(1)
+				// 回答済みの件数によらず出題数分のみ取得する
+				mockRepo.EXPECT().
+					GetQuizzesByCategoryToData(gomock.Any(), "flags", 3).
+					Return([]*model.Quiz{newQuiz("quiz_001"), newQuiz("quiz_003"), newQuiz("quiz_004")}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantIDs: []string{"quiz_002", "quiz_003", "quiz_004"},
+		},
+		{
+			name:  "正常系_期日を迎えた問題のみで出題数に達する",
+			count: 1,
+			setup: func() {
+				mockCategoryRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "flags").Return(category, nil).Times(1)
+				mockProgressUseCase.EXPECT().
+					GetReviewQueue(gomock.Any(), "flags").
+					Return(&model.ReviewQueue{
+						DueQuizIDs: []string{"quiz_001", "quiz_002"},
+						Answered:   map[string]bool{"quiz_001": true, "quiz_002": true},
+					}, nil).
+					Times(1)
+				// 期日を迎えた問題も出題数分のみ取得する
+				mockRepo.EXPECT().
+					GetQuizzesByIDsToData(gomock.Any(), "flags", []string{"quiz_001"}).
+					Return([]*model.Quiz{newQuiz("quiz_001")}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantIDs: []string{"quiz_001"},
+		},
+		{
+			name:  "正常系_削除された問題は出題しない",
+			count: 2,
+			setup: func() {
+				mockCategoryRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "flags").Return(category, nil).Times(1)
+				mockProgressUseCase.EXPECT().
+					GetReviewQueue(gomock.Any(), "flags").
+					Return(&model.ReviewQueue{
+						DueQuizIDs: []string{"quiz_deleted", "quiz_001"},
+						Answered:   map[string]bool{"quiz_deleted": true, "quiz_001": true},
+					}, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetQuizzesByIDsToData(gomock.Any(), "flags", []string{"quiz_deleted", "quiz_001"}).
+					Return([]*model.Quiz{newQuiz("quiz_001")}, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetQuizzesByCategoryToData(gomock.Any(), "flags", 2).
+					Return([]*model.Quiz{newQuiz("quiz_001"), newQuiz("quiz_002")}, nil).
+					Times(1)
+			},
+			wantErr: false,
+			wantIDs: []string{"quiz_001", "quiz_002"},
+		},
+		{
+			name:  "異常系_期日を迎えた問題の取得エラー",
+			count: 2,
+			setup: func() {
+				mockCategoryRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "flags").Return(category, nil).Times(1)
+				mockProgressUseCase.EXPECT().
+					GetReviewQueue(gomock.Any(), "flags").
+					Return(&model.ReviewQueue{
+						DueQuizIDs: []string{"quiz_001"},
+						Answered:   map[string]bool{"quiz_001": true},
+					}, nil).
+					Times(1)
+				mockRepo.EXPECT().
+					GetQuizzesByIDsToData(gomock.Any(), "flags", []string{"quiz_001"}).
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+		{
+			name:  "異常系_未ログイン",
+			count: 5,
+			setup: func() {
+				mockCategoryRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "flags").Return(category, nil).Times(1)
+				mockProgressUseCase.EXPECT().
+					GetReviewQueue(gomock.Any(), "flags").
+					Return(nil, errs.NewUnauthorizedError("authentication is required for review mode")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC004,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			quizzes, err := usecase.GetReviewQuizzes(context.Background(), "flags", tt.count, 0)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, quizzes)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]string, 0, len(quizzes))
+				for _, quiz := range quizzes {
+					ids = append(ids, quiz.ID)
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+		})
+	}
+}
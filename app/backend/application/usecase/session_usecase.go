@@ -0,0 +1,135 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/locale"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type ISessionUseCase interface {
+	CreateSession(ctx context.Context, category string, count, choiceCount int) (*model.QuizSession, []*model.Quiz, error)
+	SubmitSessionAnswer(ctx context.Context, sessionID, quizID, answer string) (*model.AnswerResult, error)
+	GetSessionResult(ctx context.Context, sessionID string) (*model.SessionResult, error)
+}
+
+type SessionUseCase struct {
+	sessionRepo     repository.ISessionRepository
+	quizUseCase     IQuizUseCase
+	progressUseCase IProgressUseCase
+	now             func() time.Time
+	newID           func() string
+}
+
+func NewSessionUseCase(sessionRepo repository.ISessionRepository, quizUseCase IQuizUseCase, progressUseCase IProgressUseCase) ISessionUseCase {
+	return &SessionUseCase{
+		sessionRepo:     sessionRepo,
+		quizUseCase:     quizUseCase,
+		progressUseCase: progressUseCase,
+		now:             time.Now,
+		newID:           idgen.New,
+	}
+}
+
+func (uc *SessionUseCase) CreateSession(ctx context.Context, category string, count, choiceCount int) (*model.QuizSession, []*model.Quiz, error) {
+	// カテゴリ・出題数・選択肢数のバリデーションはクイズ取得側で行う
+	quizzes, err := uc.quizUseCase.GetQuizzesByCategory(ctx, category, count, choiceCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(quizzes) == 0 {
+		return nil, nil, errs.NewNotFoundError(fmt.Sprintf("no quizzes found for category '%s'", category))
+	}
+
+	// 取得時のシャッフル結果を出題順として固定する
+	quizIDs := make([]string, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		quizIDs = append(quizIDs, quiz.ID)
+	}
+
+	session := model.NewQuizSession(uc.newID(), category, locale.FromContext(ctx), quizIDs, uc.now())
+	if err := uc.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, nil, err
+	}
+
+	return session, quizzes, nil
+}
+
+func (uc *SessionUseCase) SubmitSessionAnswer(ctx context.Context, sessionID, quizID, answer string) (*model.AnswerResult, error) {
+	if sessionID == "" {
+		return nil, errs.NewBadRequestError("session id is required")
+	}
+	if quizID == "" {
+		return nil, errs.NewBadRequestError("quiz id is required")
+	}
+
+	session, err := uc.getSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.HasQuiz(quizID) {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("quiz '%s' is not part of session '%s'", quizID, sessionID))
+	}
+	if session.FindAnswer(quizID) != nil {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("quiz '%s' has already been answered", quizID))
+	}
+
+	// 回答時のリクエストの言語に関わらず、出題時の言語の文言で採点する
+	// 言語を保存していない以前のセッションはリクエストの言語で採点する
+	gradeCtx := ctx
+	if session.Language != "" {
+		gradeCtx = locale.WithLanguage(ctx, session.Language)
+	}
+	result, err := uc.quizUseCase.GradeAnswer(gradeCtx, quizID, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	session.RecordAnswer(result, uc.now())
+	if err := uc.sessionRepo.UpdateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	// 学習履歴はセッションへの保存に成功した回答のみ記録する（競合で保存されなかった回答は記録しない）
+	// セッションには記録済みで再送すると回答済みとして拒否されるため、学習履歴の記録に失敗しても採点結果を返す
+	if err := uc.progressUseCase.RecordAnswer(ctx, result); err != nil {
+		logging.FromContext(ctx).Error("failed to record session answer progress",
+			"sessionId", sessionID, "quizId", quizID, "error", err)
+	}
+
+	return result, nil
+}
+
+func (uc *SessionUseCase) GetSessionResult(ctx context.Context, sessionID string) (*model.SessionResult, error) {
+	if sessionID == "" {
+		return nil, errs.NewBadRequestError("session id is required")
+	}
+
+	session, err := uc.getSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.Result(), nil
+}
+
+// getSession 保持期間を過ぎたセッションは TTL で削除される前でも存在しないものとして扱う
+func (uc *SessionUseCase) getSession(ctx context.Context, sessionID string) (*model.QuizSession, error) {
+	session, err := uc.sessionRepo.GetSessionByIDToData(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.IsExpired(uc.now()) {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("session with id '%s' has expired", sessionID))
+	}
+	return session, nil
+}
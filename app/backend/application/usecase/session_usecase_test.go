@@ -0,0 +1,467 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_usecase "audio-slide-app/mocks/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var sessionTestNow = time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+func newTestSessionUseCase(sessionRepo *mock_repository.MockISessionRepository, quizUseCase *mock_usecase.MockIQuizUseCase, progressUseCase *mock_usecase.MockIProgressUseCase) *SessionUseCase {
+	return &SessionUseCase{
+		sessionRepo:     sessionRepo,
+		quizUseCase:     quizUseCase,
+		progressUseCase: progressUseCase,
+		now:             func() time.Time { return sessionTestNow },
+		newID:           func() string { return "session_001" },
+	}
+}
+
+func TestSessionUseCase_CreateSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	mockQuizUseCase := mock_usecase.NewMockIQuizUseCase(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := newTestSessionUseCase(mockSessionRepo, mockQuizUseCase, mockProgressUseCase)
+
+	quizzes := []*model.Quiz{
+		model.NewQuiz("quiz_flag_002", "url", "audio", "answer", []string{"answer"}, "flags", "explanation"),
+		model.NewQuiz("quiz_flag_001", "url", "audio", "answer", []string{"answer"}, "flags", "explanation"),
+	}
+
+	tests := []struct {
+		name         string
+		category     string
+		count        int
+		lang         string
+		setup        func()
+		wantErr      bool
+		errType      string
+		wantQuizIDs  []string
+		wantLanguage string
+	}{
+		{
+			name:     "正常系",
+			category: "flags",
+			count:    2,
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizzesByCategory(gomock.Any(), "flags", 2, 0).
+					Return(quizzes, nil).
+					Times(1)
+				mockSessionRepo.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr:      false,
+			wantQuizIDs:  []string{"quiz_flag_002", "quiz_flag_001"},
+			wantLanguage: locale.Japanese,
+		},
+		{
+			name:     "正常系_出題時の言語を保存",
+			category: "flags",
+			count:    2,
+			lang:     locale.English,
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizzesByCategory(gomock.Any(), "flags", 2, 0).
+					Return(quizzes, nil).
+					Times(1)
+				mockSessionRepo.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr:      false,
+			wantQuizIDs:  []string{"quiz_flag_002", "quiz_flag_001"},
+			wantLanguage: locale.English,
+		},
+		{
+			name:     "異常系_無効なカテゴリ",
+			category: "invalid",
+			count:    2,
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizzesByCategory(gomock.Any(), "invalid", 2, 0).
+					Return(nil, errs.NewBadRequestError("invalid category specified")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:     "異常系_クイズが存在しない",
+			category: "words",
+			count:    2,
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizzesByCategory(gomock.Any(), "words", 2, 0).
+					Return([]*model.Quiz{}, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+		{
+			name:     "異常系_リポジトリエラー",
+			category: "flags",
+			count:    2,
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizzesByCategory(gomock.Any(), "flags", 2, 0).
+					Return(quizzes, nil).
+					Times(1)
+				mockSessionRepo.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Return(errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := context.Background()
+			if tt.lang != "" {
+				ctx = locale.WithLanguage(ctx, tt.lang)
+			}
+
+			session, result, err := usecase.CreateSession(ctx, tt.category, tt.count, 0)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, session)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "session_001", session.ID)
+				assert.Equal(t, tt.wantQuizIDs, session.QuizIDs)
+				assert.Equal(t, tt.wantLanguage, session.Language)
+				assert.Equal(t, sessionTestNow, session.StartedAt)
+				assert.Len(t, result, len(tt.wantQuizIDs))
+			}
+		})
+	}
+}
+
+func TestSessionUseCase_SubmitSessionAnswer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	mockQuizUseCase := mock_usecase.NewMockIQuizUseCase(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := newTestSessionUseCase(mockSessionRepo, mockQuizUseCase, mockProgressUseCase)
+
+	newSession := func() *model.QuizSession {
+		return model.NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001", "quiz_flag_002"}, sessionTestNow)
+	}
+	answerResult := &model.AnswerResult{QuizID: "quiz_flag_001", Answer: "日本", IsCorrect: true, CorrectAnswer: "日本"}
+
+	tests := []struct {
+		name      string
+		sessionID string
+		quizID    string
+		answer    string
+		setup     func()
+		wantErr   bool
+		errType   string
+	}{
+		{
+			name:      "正常系",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(newSession(), nil).
+					Times(1)
+				mockQuizUseCase.EXPECT().
+					GradeAnswer(gomock.Any(), "quiz_flag_001", "日本").
+					Return(answerResult, nil).
+					Times(1)
+				// 学習履歴はセッションへの保存後に記録する
+				gomock.InOrder(
+					mockSessionRepo.EXPECT().
+						UpdateSession(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, session *model.QuizSession) error {
+							assert.Len(t, session.Answers, 1)
+							assert.Equal(t, sessionTestNow, session.Answers[0].AnsweredAt)
+							return nil
+						}).
+						Times(1),
+					mockProgressUseCase.EXPECT().
+						RecordAnswer(gomock.Any(), answerResult).
+						Return(nil).
+						Times(1),
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "正常系_出題時の言語で採点する",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				// 英語で出題したセッションに日本語（デフォルト）のリクエストで回答する
+				session := model.NewQuizSession("session_001", "flags", locale.English, []string{"quiz_flag_001"}, sessionTestNow)
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(session, nil).
+					Times(1)
+				mockQuizUseCase.EXPECT().
+					GradeAnswer(gomock.Any(), "quiz_flag_001", "日本").
+					DoAndReturn(func(ctx context.Context, _, _ string) (*model.AnswerResult, error) {
+						assert.Equal(t, locale.English, locale.FromContext(ctx))
+						return answerResult, nil
+					}).
+					Times(1)
+				mockSessionRepo.EXPECT().
+					UpdateSession(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), answerResult).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "正常系_学習履歴の記録に失敗しても採点結果を返す",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(newSession(), nil).
+					Times(1)
+				mockQuizUseCase.EXPECT().
+					GradeAnswer(gomock.Any(), "quiz_flag_001", "日本").
+					Return(answerResult, nil).
+					Times(1)
+				mockSessionRepo.EXPECT().
+					UpdateSession(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+				mockProgressUseCase.EXPECT().
+					RecordAnswer(gomock.Any(), answerResult).
+					Return(errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "異常系_空のセッションID",
+			sessionID: "",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup:     func() {},
+			wantErr:   true,
+			errType:   errs.EC001,
+		},
+		{
+			name:      "異常系_セッションが見つからない",
+			sessionID: "nonexistent",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("session not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+		{
+			name:      "異常系_セッション外のクイズ",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_999",
+			answer:    "日本",
+			setup: func() {
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(newSession(), nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:      "異常系_期限切れのセッション",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				// TTL で削除される前に取得された期限切れのセッション
+				session := model.NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001"}, sessionTestNow.Add(-model.SessionTTL))
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(session, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+		{
+			name:      "異常系_同時回答で更新が競合",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(newSession(), nil).
+					Times(1)
+				mockQuizUseCase.EXPECT().
+					GradeAnswer(gomock.Any(), "quiz_flag_001", "日本").
+					Return(answerResult, nil).
+					Times(1)
+				// 保存されなかった回答は学習履歴に記録しない（RecordAnswer は呼ばれない）
+				mockSessionRepo.EXPECT().
+					UpdateSession(gomock.Any(), gomock.Any()).
+					Return(errs.NewBadRequestError("session 'session_001' was updated by another request")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:      "異常系_回答済みのクイズ",
+			sessionID: "session_001",
+			quizID:    "quiz_flag_001",
+			answer:    "日本",
+			setup: func() {
+				session := newSession()
+				session.RecordAnswer(answerResult, sessionTestNow)
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(session, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.SubmitSessionAnswer(context.Background(), tt.sessionID, tt.quizID, tt.answer)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, answerResult, result)
+			}
+		})
+	}
+}
+
+func TestSessionUseCase_GetSessionResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	mockQuizUseCase := mock_usecase.NewMockIQuizUseCase(ctrl)
+	mockProgressUseCase := mock_usecase.NewMockIProgressUseCase(ctrl)
+	usecase := newTestSessionUseCase(mockSessionRepo, mockQuizUseCase, mockProgressUseCase)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		setup     func()
+		wantErr   bool
+		errType   string
+	}{
+		{
+			name:      "正常系",
+			sessionID: "session_001",
+			setup: func() {
+				session := model.NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001"}, sessionTestNow)
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(session, nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "異常系_空のセッションID",
+			sessionID: "",
+			setup:     func() {},
+			wantErr:   true,
+			errType:   errs.EC001,
+		},
+		{
+			name:      "異常系_期限切れのセッション",
+			sessionID: "session_001",
+			setup: func() {
+				session := model.NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001"}, sessionTestNow.Add(-2*model.SessionTTL))
+				mockSessionRepo.EXPECT().
+					GetSessionByIDToData(gomock.Any(), "session_001").
+					Return(session, nil).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			result, err := usecase.GetSessionResult(context.Background(), tt.sessionID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "session_001", result.SessionID)
+				assert.Equal(t, 1, result.Total)
+			}
+		})
+	}
+}
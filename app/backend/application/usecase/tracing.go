@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName ユースケースのスパンの計装名
+const tracerName = "audio-slide-app/application/usecase"
+
+// TracedQuizUseCase IQuizUseCase の呼び出しごとにスパンを作成するデコレーター
+// リポジトリの呼び出し（DynamoDB のスパン）はユースケースのスパンの子になる
+type TracedQuizUseCase struct {
+	inner  IQuizUseCase
+	tracer trace.Tracer
+}
+
+// NewTracedQuizUseCase otel に登録された TracerProvider（main で設定する）でスパンを作成する
+func NewTracedQuizUseCase(inner IQuizUseCase) IQuizUseCase {
+	return &TracedQuizUseCase{
+		inner:  inner,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+func (uc *TracedQuizUseCase) GetQuizzesByCategory(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
+	ctx, span := uc.tracer.Start(ctx, "QuizUseCase.GetQuizzesByCategory", trace.WithAttributes(
+		attribute.String("quiz.category", category),
+		attribute.Int("quiz.count", count),
+		attribute.Int("quiz.choice_count", choiceCount),
+	))
+	quizzes, err := uc.inner.GetQuizzesByCategory(ctx, category, count, choiceCount)
+	endSpan(span, err)
+	return quizzes, err
+}
+
+func (uc *TracedQuizUseCase) GetReviewQuizzes(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
+	ctx, span := uc.tracer.Start(ctx, "QuizUseCase.GetReviewQuizzes", trace.WithAttributes(
+		attribute.String("quiz.category", category),
+		attribute.Int("quiz.count", count),
+		attribute.Int("quiz.choice_count", choiceCount),
+	))
+	quizzes, err := uc.inner.GetReviewQuizzes(ctx, category, count, choiceCount)
+	endSpan(span, err)
+	return quizzes, err
+}
+
+func (uc *TracedQuizUseCase) GetQuizByID(ctx context.Context, id string, choiceCount int) (*model.Quiz, error) {
+	ctx, span := uc.tracer.Start(ctx, "QuizUseCase.GetQuizByID", trace.WithAttributes(
+		attribute.String("quiz.id", id),
+		attribute.Int("quiz.choice_count", choiceCount),
+	))
+	quiz, err := uc.inner.GetQuizByID(ctx, id, choiceCount)
+	endSpan(span, err)
+	return quiz, err
+}
+
+func (uc *TracedQuizUseCase) SubmitAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	ctx, span := uc.tracer.Start(ctx, "QuizUseCase.SubmitAnswer", trace.WithAttributes(
+		attribute.String("quiz.id", id),
+	))
+	result, err := uc.inner.SubmitAnswer(ctx, id, answer)
+	endSpan(span, err)
+	return result, err
+}
+
+func (uc *TracedQuizUseCase) GradeAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	ctx, span := uc.tracer.Start(ctx, "QuizUseCase.GradeAnswer", trace.WithAttributes(
+		attribute.String("quiz.id", id),
+	))
+	result, err := uc.inner.GradeAnswer(ctx, id, answer)
+	endSpan(span, err)
+	return result, err
+}
+
+// endSpan エラーを記録してスパンを終了する
+// 入力エラーや見つからない場合などの利用者側のエラーはエラーコードのみ記録し、サーバーのエラー（EC003）のみスパンを失敗にする
+func endSpan(span trace.Span, err error) {
+	defer span.End()
+	if err == nil {
+		return
+	}
+
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) {
+		span.SetAttributes(attribute.String("app.error_code", appErr.Code))
+		if appErr.Code != errs.EC003 {
+			return
+		}
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	mock_usecase "audio-slide-app/mocks/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTracedQuizUseCase_GetQuizByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuizUseCase := mock_usecase.NewMockIQuizUseCase(ctrl)
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	usecase := &TracedQuizUseCase{
+		inner:  mockQuizUseCase,
+		tracer: provider.Tracer(tracerName),
+	}
+
+	tests := []struct {
+		name          string
+		setup         func()
+		wantErr       bool
+		wantStatus    codes.Code
+		wantErrorCode string
+	}{
+		{
+			name: "正常系",
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizByID(gomock.Any(), "quiz_flag_001", 4).
+					Return(model.NewQuiz("quiz_flag_001", "images/flags/it.png", "", "イタリア", []string{"イタリア"}, "flags", ""), nil).
+					Times(1)
+			},
+			wantStatus: codes.Unset,
+		},
+		{
+			name: "異常系_見つからない場合はスパンを失敗にしない",
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizByID(gomock.Any(), "quiz_flag_001", 4).
+					Return(nil, errs.NewNotFoundError("quiz not found")).
+					Times(1)
+			},
+			wantErr:       true,
+			wantStatus:    codes.Unset,
+			wantErrorCode: errs.EC002,
+		},
+		{
+			name: "異常系_内部エラー",
+			setup: func() {
+				mockQuizUseCase.EXPECT().
+					GetQuizByID(gomock.Any(), "quiz_flag_001", 4).
+					Return(nil, errs.NewInternalServerError(errors.New("database error"))).
+					Times(1)
+			},
+			wantErr:       true,
+			wantStatus:    codes.Error,
+			wantErrorCode: errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+			tt.setup()
+
+			parentCtx, parent := provider.Tracer("test").Start(context.Background(), "GET /api/quiz/:id")
+			_, err := usecase.GetQuizByID(parentCtx, "quiz_flag_001", 4)
+			parent.End()
+
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			spans := exporter.GetSpans()
+			if assert.Len(t, spans, 2) {
+				span := spans[0]
+				assert.Equal(t, "QuizUseCase.GetQuizByID", span.Name)
+				// ハンドラーのスパンの子になる
+				assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+				assert.Contains(t, span.Attributes, attribute.String("quiz.id", "quiz_flag_001"))
+				assert.Equal(t, tt.wantStatus, span.Status.Code)
+				if tt.wantErrorCode != "" {
+					assert.Contains(t, span.Attributes, attribute.String("app.error_code", tt.wantErrorCode))
+				}
+			}
+		})
+	}
+}
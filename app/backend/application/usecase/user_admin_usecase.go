@@ -0,0 +1,66 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/usecase/mock_$GOFILE -package=mock_usecase
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type IUserAdminUseCase interface {
+	UpdateUserRole(ctx context.Context, id string, req *dto.UpdateUserRoleRequest) (*model.User, error)
+}
+
+type UserAdminUseCase struct {
+	userRepo repository.IUserRepository
+	now      func() time.Time
+}
+
+func NewUserAdminUseCase(userRepo repository.IUserRepository) IUserAdminUseCase {
+	return &UserAdminUseCase{
+		userRepo: userRepo,
+		now:      time.Now,
+	}
+}
+
+// UpdateUserRole ユーザーの役割を変更する（変更後の役割はトークンの再発行時に反映される）
+func (uc *UserAdminUseCase) UpdateUserRole(ctx context.Context, id string, req *dto.UpdateUserRoleRequest) (*model.User, error) {
+	if err := authorize(ctx, model.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errs.NewBadRequestError("user id is required")
+	}
+	role := model.Role(req.Role)
+	if !role.IsValid() {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("role '%s' is not supported", req.Role))
+	}
+	// 管理者がいなくなるのを防ぐため、自分自身の役割は変更できない
+	if principal, ok := authctx.PrincipalFromContext(ctx); ok && principal.UserID == id {
+		return nil, errs.NewBadRequestError("cannot change your own role")
+	}
+
+	user, err := uc.userRepo.GetUserByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRole := user.EffectiveRole()
+	user.Role = role
+	user.UpdatedAt = uc.now()
+	if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("user role updated", "userId", user.ID, "from", previousRole, "to", role)
+
+	return user, nil
+}
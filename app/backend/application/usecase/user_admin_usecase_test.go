@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserAdminUseCase_UpdateUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock_repository.NewMockIUserRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	usecase := &UserAdminUseCase{
+		userRepo: mockUserRepo,
+		now:      func() time.Time { return now },
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		req     *dto.UpdateUserRoleRequest
+		setup   func()
+		wantErr bool
+		errType string
+	}{
+		{
+			name: "正常系",
+			id:   "user_002",
+			req:  &dto.UpdateUserRoleRequest{Role: "teacher"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByIDToData(gomock.Any(), "user_002").
+					Return(model.NewUser("user_002", "hanako@example.com", "", "", now.Add(-time.Hour)), nil).
+					Times(1)
+				mockUserRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: false,
+		},
+		{
+			name:    "異常系_未定義の役割",
+			id:      "user_002",
+			req:     &dto.UpdateUserRoleRequest{Role: "owner"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name:    "異常系_自分自身の役割の変更",
+			id:      "user_admin",
+			req:     &dto.UpdateUserRoleRequest{Role: "learner"},
+			setup:   func() {},
+			wantErr: true,
+			errType: errs.EC001,
+		},
+		{
+			name: "異常系_ユーザーが見つからない",
+			id:   "nonexistent",
+			req:  &dto.UpdateUserRoleRequest{Role: "teacher"},
+			setup: func() {
+				mockUserRepo.EXPECT().
+					GetUserByIDToData(gomock.Any(), "nonexistent").
+					Return(nil, errs.NewNotFoundError("user not found")).
+					Times(1)
+			},
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			user, err := usecase.UpdateUserRole(contextWithRole(model.RoleAdmin), tt.id, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errType != "" {
+					if appErr, ok := err.(*errs.AppError); ok {
+						assert.Equal(t, tt.errType, appErr.Code)
+					}
+				}
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.RoleTeacher, user.Role)
+				assert.Equal(t, now, user.UpdatedAt)
+			}
+		})
+	}
+}
@@ -1,68 +1,329 @@
 package main
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
 
+	"audio-slide-app/common/cursor"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/logging"
 	"audio-slide-app/config"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/service"
+	"audio-slide-app/infrastructure/auth"
 	"audio-slide-app/infrastructure/dynamodb"
+	"audio-slide-app/infrastructure/health"
+	"audio-slide-app/infrastructure/media"
+	"audio-slide-app/infrastructure/memory"
+	"audio-slide-app/infrastructure/metrics"
+	"audio-slide-app/infrastructure/tracing"
 	"audio-slide-app/interface/handler"
+	"audio-slide-app/interface/middleware"
+	"audio-slide-app/interface/server"
 
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func main() {
 	cfg := config.NewConfig()
 
+	// JSON 形式の構造化ログ（リクエストごとのロガーは RequestLogger ミドルウェアで context に格納する）
+	logger := logging.New(os.Stdout, logging.ParseLevel(cfg.LogLevel))
+	slog.SetDefault(logger)
+
+	// トレース（ハンドラー → ユースケース → DynamoDB のスパン）
+	tracerProvider, shutdownTracing, err := newTracerProvider(context.Background(), cfg)
+	if err != nil {
+		fatal(logger, "failed to create tracer provider", err)
+	}
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
 	// DynamoDB接続
 	dynamoDBClient, err := dynamodb.NewClient(cfg.DynamoDBEndpoint, cfg.AWSRegion)
 	if err != nil {
-		log.Fatalf("Failed to create DynamoDB client: %v", err)
+		fatal(logger, "failed to create DynamoDB client", err)
 	}
 
-	fmt.Println("DynamoDBClientStatus: ", map[string]interface{}{
-		"endpoint": cfg.DynamoDBEndpoint,
-		"region":   cfg.AWSRegion,
-		"dynamodbClient": map[string]interface{}{
-			"endpoint":        dynamoDBClient.Endpoint,
-			"region":          dynamoDBClient.Config.Region,
-			"config_endpoint": dynamoDBClient.Config.Endpoint,
-		},
-	})
+	logger.Info("dynamodb client created",
+		"endpoint", cfg.DynamoDBEndpoint,
+		"region", cfg.AWSRegion,
+		"clientEndpoint", dynamoDBClient.Endpoint,
+	)
+
+	// Prometheus のメトリクス（/metrics で公開する）
+	appMetrics := metrics.New()
+	// DynamoDB の呼び出しごとの処理時間とスロットリングを記録する
+	dynamoDBClient = appMetrics.InstrumentDynamoDB(dynamoDBClient)
+	dynamoDBClient = tracing.InstrumentDynamoDB(dynamoDBClient, tracerProvider)
 
 	// リポジトリ初期化
+	categoryRepo := memory.NewCachedCategoryRepository(dynamodb.NewCategoryRepository(dynamoDBClient), cfg.CategoryCacheTTL)
 	quizRepo := dynamodb.NewQuizRepository(dynamoDBClient)
-	categoryRepo := dynamodb.NewCategoryRepository(dynamoDBClient)
+	sessionRepo := dynamodb.NewSessionRepository(dynamoDBClient)
+	userRepo := dynamodb.NewUserRepository(dynamoDBClient)
+	progressRepo := dynamodb.NewProgressRepository(dynamoDBClient)
+
+	// ページングカーソルの署名鍵
+	// 複数台構成では全インスタンスで同じ鍵を設定しないと、別インスタンスで発行されたカーソルが無効になる
+	cursorSecret := []byte(cfg.CursorSecret)
+	if len(cursorSecret) == 0 {
+		logger.Warn("CURSOR_SECRET is not set; using a random key (cursors become invalid after restart)")
+		cursorSecret = []byte(idgen.New())
+	}
+	cursorCodec := cursor.NewCodec(cursorSecret)
+
+	// トークンの署名鍵（未設定の場合は再起動でログイン状態が失われる）
+	jwtSecret := []byte(cfg.JWTSecret)
+	if len(jwtSecret) == 0 {
+		logger.Warn("JWT_SECRET is not set; using a random key (issued tokens become invalid after restart)")
+		jwtSecret = []byte(idgen.New())
+	}
+	tokenService := auth.NewJWTTokenService(jwtSecret, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+
+	// 画像・音声のオブジェクトキーを期限付きの URL に変換する
+	mediaSigner, err := newMediaSigner(cfg)
+	if err != nil {
+		fatal(logger, "failed to create media signer", err)
+	}
+
+	// 管理APIでアップロードされた画像・音声の保存先
+	mediaStorage, err := newMediaStorage(cfg)
+	if err != nil {
+		fatal(logger, "failed to create media storage", err)
+	}
+
+	// /api/health/ready で確認する依存先
+	healthCheckers, err := newHealthCheckers(cfg, dynamoDBClient)
+	if err != nil {
+		fatal(logger, "failed to create health checkers", err)
+	}
+
+	// 停止を始めたら /api/health/ready を失敗にする（結果は保持せず即座に反映する）
+	// ECS からの停止では SIGTERM の前にターゲットグループから登録解除されているため、それ以外の停止に備えたもの
+	shutdownChecker := health.NewShutdownChecker()
+	healthCheckers = append(healthCheckers, shutdownChecker)
 
 	// ハンドラー初期化
-	healthHandler := handler.NewHealthHandler()
-	categoryHandler := handler.NewCategoryHandler(categoryRepo)
-	quizHandler := handler.NewQuizHandler(quizRepo)
+	healthHandler := handler.NewHealthHandler(healthCheckers, cfg.HealthCheckTimeout)
+	categoryHandler := handler.NewCategoryHandler(categoryRepo, quizRepo, cursorCodec, mediaSigner)
+	quizHandler := handler.NewQuizHandler(quizRepo, categoryRepo, progressRepo, mediaSigner)
+	sessionHandler := handler.NewSessionHandler(sessionRepo, quizRepo, categoryRepo, progressRepo, mediaSigner)
+	adminQuizHandler := handler.NewAdminQuizHandler(quizRepo, categoryRepo)
+	adminCategoryHandler := handler.NewAdminCategoryHandler(categoryRepo, quizRepo)
+	authHandler := handler.NewAuthHandler(userRepo, tokenService)
+	adminUserHandler := handler.NewAdminUserHandler(userRepo)
+	adminMediaHandler := handler.NewAdminMediaHandler(categoryRepo, mediaStorage, mediaSigner)
+	progressHandler := handler.NewProgressHandler(progressRepo, categoryRepo, quizRepo)
 
 	// Ginルーター設定
-	r := gin.Default()
+	// Gin 標準のテキスト形式のロガーは使わず、JSON のアクセスログを出力する
+	r := gin.New()
+	r.Use(middleware.Tracing(tracerProvider), middleware.RequestLogger(logger), middleware.Metrics(appMetrics), middleware.Recovery())
+
+	// メトリクスは ALB で転送しない（/api 配下に置かない）ため、VPC 内からのみ取得できる
+	r.GET("/metrics", gin.WrapH(appMetrics.Handler()))
 
 	// CORS設定
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowOrigins = []string{"http://localhost:3000", "https://audio-slide-app.com"}
-	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	corsConfig.AllowHeaders = []string{"Content-Type", "Authorization"}
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Content-Type", "Authorization", "Accept-Language", middleware.AdminAPIKeyHeader, middleware.RequestIDHeader, "traceparent", "tracestate"}
+	corsConfig.ExposeHeaders = []string{middleware.RequestIDHeader}
 	r.Use(cors.New(corsConfig))
 
+	// ローカルに保存した画像・音声の配信（MEDIA_SIGNER=public と MEDIA_BASE_URL=http://localhost:8080/media を組み合わせる）
+	if cfg.MediaStorage == config.MediaStorageLocal {
+		r.Static("/media", cfg.MediaLocalDir)
+	}
+
 	// ルート設定
-	api := r.Group("/api")
+	// Authorization ヘッダーがあればユーザーを context に格納する（なければ匿名アクセス）
+	// エラーメッセージも翻訳するため、認証より先に言語を決める
+	api := r.Group("/api", middleware.Locale(), middleware.Authenticate(userRepo, tokenService))
 	{
-		api.GET("/health", healthHandler.GetHealth)
+		// /api/health は /api/health/live と同じ（互換性のため残す）
+		api.GET("/health", healthHandler.GetLive)
+		api.GET("/health/live", healthHandler.GetLive)
+		api.GET("/health/ready", healthHandler.GetReady)
 		api.GET("/categories", categoryHandler.GetCategories)
+		api.GET("/categories/:id/quizzes", categoryHandler.GetCategoryQuizzes)
 		api.GET("/quiz", quizHandler.GetQuizzes)
 		api.GET("/quiz/:id", quizHandler.GetQuizByID)
+		api.POST("/quiz/:id/answer", quizHandler.SubmitAnswer)
+		api.POST("/sessions", sessionHandler.CreateSession)
+		api.POST("/sessions/:id/answers", sessionHandler.SubmitAnswer)
+		api.GET("/sessions/:id/result", sessionHandler.GetResult)
+		api.POST("/auth/signup", authHandler.SignUp)
+		api.POST("/auth/login", authHandler.Login)
+		api.POST("/auth/refresh", authHandler.Refresh)
+		api.GET("/me", middleware.RequireUser(), authHandler.GetMe)
+		api.GET("/me/progress", middleware.RequireUser(), progressHandler.GetProgress)
+		api.GET("/me/progress/:category", middleware.RequireUser(), progressHandler.GetCategoryProgress)
+	}
+
+	// 管理API（先生・管理者のみ、管理APIキーの場合は管理者として扱う）
+	admin := api.Group("/admin", middleware.AdminAuth(cfg.AdminAPIKey), middleware.RequireRole(model.RoleTeacher, model.RoleAdmin))
+	{
+		admin.POST("/quizzes", adminQuizHandler.CreateQuiz)
+		admin.PUT("/quizzes/:id", adminQuizHandler.UpdateQuiz)
+		admin.PATCH("/quizzes/:id", adminQuizHandler.PatchQuiz)
+		admin.DELETE("/quizzes/:id", adminQuizHandler.DeleteQuiz)
+		admin.POST("/media", adminMediaHandler.UploadMedia)
+	}
+
+	// カテゴリ・ユーザーの管理は管理者のみ
+	adminOnly := admin.Group("", middleware.RequireRole(model.RoleAdmin))
+	{
+		adminOnly.POST("/categories", adminCategoryHandler.CreateCategory)
+		adminOnly.PUT("/categories/:id", adminCategoryHandler.UpdateCategory)
+		adminOnly.DELETE("/categories/:id", adminCategoryHandler.DeleteCategory)
+		adminOnly.PUT("/users/:id/role", adminUserHandler.UpdateUserRole)
 	}
 
 	// サーバー起動
-	port := fmt.Sprintf(":%s", cfg.Port)
-	log.Printf("Server starting on port %s", cfg.Port)
-	if err := r.Run(port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	// ECS のタスク停止（SIGTERM）では、処理中のリクエストを待ってから終了する
+	// 停止中にもう一度 SIGTERM / SIGINT を受け取った場合は待機を打ち切って終了する
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	forceStop := make(chan struct{})
+	go func() {
+		<-signals
+		stop()
+		<-signals
+		close(forceStop)
+	}()
+	srv := server.New(r, server.Options{
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		MaxHeaderBytes:    cfg.ServerMaxHeaderBytes,
+		DrainDelay:        cfg.ShutdownDrainDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+		OnDrain:           shutdownChecker.Drain,
+		ForceStop:         forceStop,
+	})
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.Port))
+	if err != nil {
+		fatal(logger, "failed to listen", err)
+	}
+
+	logger.Info("server starting", "port", cfg.Port)
+	serveErr := srv.Serve(ctx, listener)
+
+	// 送信待ちのスパンを書き出す（リクエストの完了待ちとは別の上限で、stopTimeout に収める）
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.TracingShutdownTimeout)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Error("failed to shut down tracer provider", "error", err)
+	}
+	cancel()
+
+	if serveErr != nil {
+		fatal(logger, "server stopped with error", serveErr)
+	}
+}
+
+// fatal 起動時のエラーをログに出力して終了する
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// newMediaSigner 設定に応じて画像・音声の URL の発行方式を選ぶ
+func newMediaSigner(cfg *config.Config) (service.IMediaSigner, error) {
+	switch cfg.MediaSigner {
+	case config.MediaSignerS3:
+		client, err := media.NewS3Client(cfg.S3Region)
+		if err != nil {
+			return nil, err
+		}
+		return media.NewS3Signer(client, cfg.S3BucketName, cfg.MediaURLTTL), nil
+	case config.MediaSignerCloudFront:
+		if cfg.CloudFrontDomain == "" || cfg.CloudFrontKeyPairID == "" {
+			return nil, fmt.Errorf("CLOUDFRONT_DOMAIN and CLOUDFRONT_KEY_PAIR_ID are required for the cloudfront media signer")
+		}
+		privateKey, err := media.LoadCloudFrontPrivateKey(cfg.CloudFrontPrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load CloudFront private key: %w", err)
+		}
+		return media.NewCloudFrontSigner(cfg.CloudFrontDomain, cfg.CloudFrontKeyPairID, privateKey, cfg.S3BucketName, cfg.MediaURLTTL), nil
+	case config.MediaSignerPublic:
+		baseURL := cfg.MediaBaseURL
+		if baseURL == "" {
+			baseURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.S3BucketName, cfg.S3Region)
+		}
+		return media.NewPublicURLSigner(baseURL, cfg.S3BucketName), nil
+	}
+	return nil, fmt.Errorf("unknown MEDIA_SIGNER '%s'", cfg.MediaSigner)
+}
+
+// newTracerProvider 設定に応じてトレースの送信先を選ぶ（none の場合はスパンを記録しない）
+func newTracerProvider(ctx context.Context, cfg *config.Config) (trace.TracerProvider, func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.TracingExporter {
+	case config.TracingExporterOTLP:
+		exporter, err = tracing.NewOTLPExporter(ctx)
+	case config.TracingExporterStdout:
+		exporter, err = tracing.NewStdoutExporter(os.Stdout)
+	case config.TracingExporterNone:
+		return noop.NewTracerProvider(), func(context.Context) error { return nil }, nil
+	default:
+		err = fmt.Errorf("unknown TRACING_EXPORTER '%s'", cfg.TracingExporter)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := tracing.NewTracerProvider(exporter, cfg.TracingServiceName, cfg.TracingSampleRatio)
+	return provider, provider.Shutdown, nil
+}
+
+// newMediaStorage 設定に応じて画像・音声の保存先を選ぶ
+func newMediaStorage(cfg *config.Config) (service.IMediaStorage, error) {
+	switch cfg.MediaStorage {
+	case config.MediaStorageS3:
+		client, err := media.NewS3Client(cfg.S3Region)
+		if err != nil {
+			return nil, err
+		}
+		return media.NewS3Storage(client, cfg.S3BucketName), nil
+	case config.MediaStorageLocal:
+		return media.NewLocalStorage(cfg.MediaLocalDir), nil
+	}
+	return nil, fmt.Errorf("unknown MEDIA_STORAGE '%s'", cfg.MediaStorage)
+}
+
+// newHealthCheckers DynamoDB のテーブル、（S3 を使う場合は）画像・音声のバケット、設定値を確認する
+// AWS の API を呼び出す確認は HEALTH_CHECK_CACHE_TTL の間結果を保持する
+func newHealthCheckers(cfg *config.Config, dynamoDBClient *awsdynamodb.DynamoDB) ([]service.IHealthChecker, error) {
+	checkers := []service.IHealthChecker{
+		health.NewCachedChecker(health.NewDynamoDBTableChecker(dynamoDBClient, dynamodb.QuizTableName), cfg.HealthCheckCacheTTL),
+		health.NewFuncChecker("config", func(context.Context) error { return cfg.Validate() }),
 	}
+
+	if cfg.MediaStorage == config.MediaStorageS3 || cfg.MediaSigner == config.MediaSignerS3 {
+		client, err := media.NewS3Client(cfg.S3Region)
+		if err != nil {
+			return nil, err
+		}
+		checkers = append(checkers, health.NewCachedChecker(health.NewS3BucketChecker(client, cfg.S3BucketName), cfg.HealthCheckCacheTTL))
+	}
+
+	return checkers, nil
 }
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/config"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/infrastructure/dynamodb"
+	"audio-slide-app/interface/job"
+)
+
+const usage = `quizctl はクイズコンテンツを Quiz テーブルに一括登録・出力するコマンドです。
+
+Usage:
+  quizctl import [-dry-run] [-upsert] [-prune] [-format json|yaml|csv] <file>
+  quizctl export [-format json|yaml|csv] [-o <file>]
+  quizctl lint
+  quizctl reindex
+
+lint はテーブル上のすべてのクイズをチェックし、問題があれば終了コード 1 で終了します。
+reindex はランダム出題用のキー（randomKey）を持たないクイズに割り当てます（randomKey 導入前のデータの移行用）。
+
+接続先は DYNAMODB_ENDPOINT / AWS_REGION 環境変数で指定します（DynamoDB Local: http://localhost:8000）。
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg := config.NewConfig()
+
+	dynamoDBClient, err := dynamodb.NewClient(cfg.DynamoDBEndpoint, cfg.AWSRegion)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create DynamoDB client: %v\n", err)
+		os.Exit(1)
+	}
+
+	categoryRepo := dynamodb.NewCategoryRepository(dynamoDBClient)
+	quizRepo := dynamodb.NewQuizRepository(dynamoDBClient)
+	contentJob := job.NewContentJob(categoryRepo, quizRepo)
+
+	// コマンドはテーブルへの直接の接続情報を持つ運用者が実行するため、管理者として扱う
+	ctx := authctx.WithPrincipal(context.Background(), &authctx.Principal{
+		UserID: "quizctl",
+		Role:   string(model.RoleAdmin),
+	})
+	switch os.Args[1] {
+	case "import":
+		err = runImport(ctx, contentJob, os.Args[2:])
+	case "export":
+		err = runExport(ctx, contentJob, os.Args[2:])
+	case "lint":
+		var invalid int
+		invalid, err = contentJob.Lint(ctx, os.Stdout)
+		if err == nil && invalid > 0 {
+			os.Exit(1)
+		}
+	case "reindex":
+		err = contentJob.Reindex(ctx, os.Stdout)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runImport(ctx context.Context, contentJob *job.ContentJob, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "書き込みを行わずに変更内容のみを表示する")
+	upsert := fs.Bool("upsert", false, "既存のアイテムを上書きする（指定しない場合はスキップ）")
+	prune := fs.Bool("prune", false, "ファイルに含まれないアイテムを削除する")
+	format := fs.String("format", "", "入力フォーマット（省略時は拡張子から判定）")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import requires exactly one file")
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		detected, err := job.DetectFormat(path)
+		if err != nil {
+			return err
+		}
+		*format = detected
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	opts := dto.ImportOptions{
+		DryRun: *dryRun,
+		Upsert: *upsert,
+		Prune:  *prune,
+	}
+	return contentJob.Import(ctx, file, *format, opts, os.Stdout)
+}
+
+func runExport(ctx context.Context, contentJob *job.ContentJob, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "出力フォーマット（省略時は出力ファイルの拡張子から判定、標準出力の場合は json）")
+	output := fs.String("o", "", "出力ファイル（省略時は標準出力）")
+	fs.Parse(args)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		if *format == "" {
+			detected, err := job.DetectFormat(*output)
+			if err != nil {
+				return err
+			}
+			*format = detected
+		}
+
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if *format == "" {
+		*format = job.FormatJSON
+	}
+
+	return contentJob.Export(ctx, w, *format)
+}
@@ -0,0 +1,24 @@
+package authctx
+
+import "context"
+
+// Principal 認証済みのリクエストを行ったユーザー
+type Principal struct {
+	UserID string
+	Email  string
+	// Role ユーザーの役割（learner / teacher / admin）
+	Role string
+}
+
+type principalKey struct{}
+
+// WithPrincipal 認証済みユーザーを context に格納する
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext context から認証済みユーザーを取り出す（匿名アクセスの場合は false）
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
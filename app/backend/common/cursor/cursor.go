@@ -0,0 +1,63 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalid 改ざんされた、または形式が不正なカーソル
+var ErrInvalid = errors.New("invalid cursor")
+
+// Codec ページングの継続位置を署名付きの不透明な文字列に変換する
+// クライアントはカーソルの中身を解釈・改変できない
+type Codec struct {
+	secret []byte
+}
+
+func NewCodec(secret []byte) *Codec {
+	return &Codec{
+		secret: secret,
+	}
+}
+
+// Encode payload を JSON にし、HMAC-SHA256 の署名を付けて返す
+func (c *Codec) Encode(payload interface{}) (string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	encodedBody := base64.RawURLEncoding.EncodeToString(body)
+	return encodedBody + "." + c.sign(encodedBody), nil
+}
+
+// Decode 署名を検証し、payload に復元する
+func (c *Codec) Decode(token string, payload interface{}) error {
+	encodedBody, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalid
+	}
+	if !hmac.Equal([]byte(signature), []byte(c.sign(encodedBody))) {
+		return ErrInvalid
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(encodedBody)
+	if err != nil {
+		return ErrInvalid
+	}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return ErrInvalid
+	}
+
+	return nil
+}
+
+func (c *Codec) sign(encodedBody string) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(encodedBody))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPayload struct {
+	Category string            `json:"c"`
+	Key      map[string]string `json:"k"`
+}
+
+func TestCodec(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	payload := testPayload{Category: "flags", Key: map[string]string{"PK": "CATEGORY#flags", "SK": "QUIZ#quiz_flag_010"}}
+
+	token, err := codec.Encode(payload)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		codec   *Codec
+		token   string
+		wantErr bool
+	}{
+		{
+			name:    "正常系",
+			codec:   codec,
+			token:   token,
+			wantErr: false,
+		},
+		{
+			name:    "異常系_改ざんされたカーソル",
+			codec:   codec,
+			token:   "x" + token,
+			wantErr: true,
+		},
+		{
+			name:    "異常系_別の鍵で署名されたカーソル",
+			codec:   NewCodec([]byte("other")),
+			token:   token,
+			wantErr: true,
+		},
+		{
+			name:    "異常系_署名のないカーソル",
+			codec:   codec,
+			token:   "eyJjIjoiZmxhZ3MifQ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded testPayload
+			err := tt.codec.Decode(tt.token, &decoded)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalid)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, payload, decoded)
+			}
+		})
+	}
+}
@@ -1,26 +1,66 @@
 package errs
 
 import (
+	"errors"
 	"fmt"
+
+	"audio-slide-app/common/locale"
 )
 
 const (
 	EC001 = "EC001"
 	EC002 = "EC002"
 	EC003 = "EC003"
+	EC004 = "EC004"
+	EC005 = "EC005"
 )
 
 var (
 	EC001Message = "リクエストパラメータエラー"
 	EC002Message = "リソースが見つからない"
 	EC003Message = "内部サーバーエラー"
+	EC004Message = "認証エラー"
+	EC005Message = "権限エラー"
 )
 
+// messages 言語ごとのエラーメッセージ（翻訳がない場合は日本語のメッセージを使う）
+var messages = map[string]map[string]string{
+	locale.Japanese: {
+		EC001: EC001Message,
+		EC002: EC002Message,
+		EC003: EC003Message,
+		EC004: EC004Message,
+		EC005: EC005Message,
+	},
+	locale.English: {
+		EC001: "Invalid request parameters",
+		EC002: "Resource not found",
+		EC003: "Internal server error",
+		EC004: "Authentication error",
+		EC005: "Permission denied",
+	},
+}
+
+// LocalizedMessage エラーコードのメッセージを指定した言語で返す（未知のコードの場合は空文字）
+func LocalizedMessage(code, lang string) string {
+	if message, ok := messages[lang][code]; ok {
+		return message
+	}
+	return messages[locale.Default][code]
+}
+
 type AppError struct {
-	Code    string `json:"code"`
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details string       `json:"details,omitempty"`
+	Fields  []FieldError `json:"fields,omitempty"`
+	Err     error        `json:"-"`
+}
+
+// FieldError 入力エラーとなった項目と理由
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
-	Err     error  `json:"-"`
 }
 
 func (e *AppError) Error() string {
@@ -51,6 +91,16 @@ func NewBadRequestError(details string) *AppError {
 	}
 }
 
+// NewValidationError 項目単位の入力エラーを持つ EC001 エラーを生成する
+func NewValidationError(details string, fields []FieldError) *AppError {
+	return &AppError{
+		Code:    EC001,
+		Message: EC001Message,
+		Details: details,
+		Fields:  fields,
+	}
+}
+
 func NewNotFoundError(details string) *AppError {
 	return &AppError{
 		Code:    EC002,
@@ -70,4 +120,26 @@ func NewInternalServerError(err error) *AppError {
 		Details: details,
 		Err:     err,
 	}
-}
\ No newline at end of file
+}
+
+func NewUnauthorizedError(details string) *AppError {
+	return &AppError{
+		Code:    EC004,
+		Message: EC004Message,
+		Details: details,
+	}
+}
+
+func NewForbiddenError(details string) *AppError {
+	return &AppError{
+		Code:    EC005,
+		Message: EC005Message,
+		Details: details,
+	}
+}
+
+// IsNotFound リソースが見つからないエラー（EC002）かを判定する
+func IsNotFound(err error) bool {
+	var appErr *AppError
+	return errors.As(err, &appErr) && appErr.Code == EC002
+}
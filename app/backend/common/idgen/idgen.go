@@ -0,0 +1,15 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// New ランダムな32文字の16進数IDを生成する
+func New() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
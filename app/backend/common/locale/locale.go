@@ -0,0 +1,109 @@
+// Package locale リクエストの言語の決定と context への格納
+package locale
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	Japanese = "ja"
+	English  = "en"
+
+	// Default 翻訳がない場合に使う言語（コンテンツの基本の言語）
+	Default = Japanese
+)
+
+// Supported 対応している言語
+var Supported = []string{Japanese, English}
+
+// IsSupported 対応している言語かを判定する
+func IsSupported(lang string) bool {
+	for _, supported := range Supported {
+		if supported == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// Negotiate lang パラメータ、Accept-Language ヘッダーの順に対応している言語を選ぶ（どちらもなければ Default）
+func Negotiate(lang, acceptLanguage string) string {
+	if normalized := normalize(lang); IsSupported(normalized) {
+		return normalized
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if normalized := normalize(tag); IsSupported(normalized) {
+			return normalized
+		}
+	}
+
+	return Default
+}
+
+type languageKey struct{}
+
+// WithLanguage リクエストの言語を context に格納する
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// FromContext context からリクエストの言語を取り出す（未設定の場合は Default）
+func FromContext(ctx context.Context) string {
+	if lang, ok := ctx.Value(languageKey{}).(string); ok && lang != "" {
+		return lang
+	}
+	return Default
+}
+
+// normalize 地域などのサブタグを除いた小文字の言語コードにする（例: en-US → en）
+func normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// parseAcceptLanguage Accept-Language ヘッダーの言語タグを優先度（q値）の高い順に返す
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	result := make([]string, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, t.tag)
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package locale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		lang           string
+		acceptLanguage string
+		want           string
+	}{
+		{
+			name: "正常系_指定なし",
+			want: Japanese,
+		},
+		{
+			name:           "正常系_langパラメータを優先",
+			lang:           "en",
+			acceptLanguage: "ja",
+			want:           English,
+		},
+		{
+			name:           "正常系_Accept-Language",
+			acceptLanguage: "en-US,en;q=0.9,ja;q=0.8",
+			want:           English,
+		},
+		{
+			name:           "正常系_q値の高い順",
+			acceptLanguage: "ja;q=0.5, en;q=0.8",
+			want:           English,
+		},
+		{
+			name:           "正常系_未対応の言語は読み飛ばす",
+			acceptLanguage: "fr-FR, de;q=0.9, en;q=0.1",
+			want:           English,
+		},
+		{
+			name:           "正常系_未対応の言語のみ",
+			acceptLanguage: "fr-FR, *;q=0.5",
+			want:           Japanese,
+		},
+		{
+			name:           "正常系_未対応のlangパラメータはAccept-Languageで決める",
+			lang:           "fr",
+			acceptLanguage: "en",
+			want:           English,
+		},
+		{
+			name:           "正常系_q値0は除外",
+			acceptLanguage: "en;q=0, ja",
+			want:           Japanese,
+		},
+		{
+			name: "正常系_大文字と地域サブタグ",
+			lang: "EN_gb",
+			want: English,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Negotiate(tt.lang, tt.acceptLanguage))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, English, FromContext(WithLanguage(context.Background(), English)))
+}
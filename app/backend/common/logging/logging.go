@@ -0,0 +1,43 @@
+// Package logging 構造化ログ（JSON）の出力とリクエストごとのロガーの受け渡し
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// New JSON 形式で出力するロガーを生成する（CloudWatch Logs で項目ごとに検索できるようにする）
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}))
+}
+
+// ParseLevel ログレベルの文字列（debug / info / warn / error）を変換する（不明な値の場合は info）
+func ParseLevel(value string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+type loggerKey struct{}
+
+// WithLogger リクエストID などを付与したロガーを context に格納する
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext context からロガーを取り出す（未設定の場合は slog.Default）
+// ユースケースやリポジトリはこのロガーを使い、ログをリクエストと紐づける
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok && logger != nil {
+		return logger
+	}
+	return slog.Default()
+}
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  slog.Level
+	}{
+		{name: "正常系_debug", value: "debug", want: slog.LevelDebug},
+		{name: "正常系_大文字", value: "WARN", want: slog.LevelWarn},
+		{name: "正常系_error", value: "error", want: slog.LevelError},
+		{name: "正常系_不明な値はinfo", value: "verbose", want: slog.LevelInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseLevel(tt.value))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("正常系_格納したロガー", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := New(&buf, slog.LevelInfo).With("requestId", "req_001")
+
+		FromContext(WithLogger(context.Background(), logger)).Info("quiz created", "quizId", "quiz_flag_001")
+
+		var line map[string]interface{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+		assert.Equal(t, "quiz created", line["msg"])
+		assert.Equal(t, "req_001", line["requestId"])
+		assert.Equal(t, "quiz_flag_001", line["quizId"])
+	})
+
+	t.Run("正常系_未設定の場合はデフォルト", func(t *testing.T) {
+		assert.Same(t, slog.Default(), FromContext(context.Background()))
+	})
+}
@@ -1,26 +1,206 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct {
 	DynamoDBEndpoint string
 	AWSRegion        string
 	Port             string
+	// LogLevel ログの出力レベル（debug / info / warn / error）
+	LogLevel string
+	// CategoryCacheTTL カテゴリ一覧のメモリキャッシュ保持時間（0でキャッシュ無効）
+	CategoryCacheTTL time.Duration
+	// AdminAPIKey 管理API（/api/admin）の認証キー（未設定の場合は管理APIを利用不可）
+	AdminAPIKey string
+	// CursorSecret ページングカーソルの署名鍵（未設定の場合は起動ごとにランダムに生成）
+	CursorSecret string
+	// JWTSecret アクセストークン・リフレッシュトークンの署名鍵（未設定の場合は起動ごとにランダムに生成）
+	JWTSecret string
+	// AccessTokenTTL アクセストークンの有効期間
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL リフレッシュトークンの有効期間
+	RefreshTokenTTL time.Duration
+	// MediaSigner 画像・音声のオブジェクトキーから URL を発行する方式（s3 / cloudfront / public）
+	MediaSigner string
+	// MediaURLTTL 署名付き URL の有効期間
+	MediaURLTTL time.Duration
+	// MediaBaseURL public の場合の配信元（未設定の場合は S3 バケットの公開 URL）
+	MediaBaseURL string
+	// MediaStorage アップロードされた画像・音声の保存先（s3 / local）
+	MediaStorage string
+	// MediaLocalDir local の場合の保存先ディレクトリ（API サーバーの /media から配信する）
+	MediaLocalDir string
+	// S3BucketName 画像・音声を保存する S3 バケット
+	S3BucketName string
+	// S3Region S3 バケットのリージョン（未設定の場合は AWSRegion）
+	S3Region string
+	// CloudFrontDomain cloudfront の場合の配信ドメイン（例: d111111abcdef8.cloudfront.net）
+	CloudFrontDomain string
+	// CloudFrontKeyPairID 署名に使うキーペアの ID
+	CloudFrontKeyPairID string
+	// CloudFrontPrivateKeyPath 署名に使う秘密鍵（PEM）のパス
+	CloudFrontPrivateKeyPath string
+	// TracingExporter トレースの送信先（otlp / stdout / none）
+	TracingExporter string
+	// TracingSampleRatio トレースを記録する割合（0〜1）
+	TracingSampleRatio float64
+	// TracingServiceName トレースに記録するサービス名
+	TracingServiceName string
+	// HealthCheckTimeout /api/health/ready で依存先ごとの確認を打ち切るまでの時間
+	HealthCheckTimeout time.Duration
+	// HealthCheckCacheTTL /api/health/ready で DynamoDB・S3 の確認結果を保持する時間（0でキャッシュ無効）
+	HealthCheckCacheTTL time.Duration
+	// ServerReadHeaderTimeout リクエストヘッダーの読み取りの上限
+	ServerReadHeaderTimeout time.Duration
+	// ServerReadTimeout リクエストボディを含む読み取りの上限（画像・音声のアップロードも収まる長さにする）
+	ServerReadTimeout time.Duration
+	// ServerWriteTimeout レスポンスの書き込みの上限
+	ServerWriteTimeout time.Duration
+	// ServerIdleTimeout キープアライブの接続を待つ上限（ALB のアイドルタイムアウト 60 秒より長くする）
+	ServerIdleTimeout time.Duration
+	// ServerMaxHeaderBytes リクエストヘッダーの最大サイズ（バイト）
+	ServerMaxHeaderBytes int
+	// ShutdownDrainDelay SIGTERM を受け取ってから新しい接続の受け付けを止めるまでの時間
+	// ALB からの登録解除は SIGTERM の前に済んでいるため、ALB への反映の遅れで届くリクエストを受けるだけの短い時間でよい
+	ShutdownDrainDelay time.Duration
+	// ShutdownTimeout 処理中のリクエストの完了を待つ上限
+	ShutdownTimeout time.Duration
+	// TracingShutdownTimeout 停止時に送信待ちのスパンを書き出す上限
+	// ShutdownDrainDelay・ShutdownTimeout との合計を ECS の stopTimeout（30 秒）より短くする
+	TracingShutdownTimeout time.Duration
 }
 
+const (
+	MediaSignerS3         = "s3"
+	MediaSignerCloudFront = "cloudfront"
+	MediaSignerPublic     = "public"
+
+	MediaStorageS3    = "s3"
+	MediaStorageLocal = "local"
+
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+	TracingExporterNone   = "none"
+)
+
 func NewConfig() *Config {
+	awsRegion := getEnv("AWS_REGION", "ap-northeast-1")
 	return &Config{
 		DynamoDBEndpoint: getEnv("DYNAMODB_ENDPOINT", ""),
-		AWSRegion:        getEnv("AWS_REGION", "ap-northeast-1"),
+		AWSRegion:        awsRegion,
 		Port:             getEnv("PORT", "8080"),
+		LogLevel:         getEnv("LOG_LEVEL", "info"),
+		CategoryCacheTTL: getEnvDuration("CATEGORY_CACHE_TTL", 30*time.Second),
+		AdminAPIKey:      getEnv("ADMIN_API_KEY", ""),
+		CursorSecret:     getEnv("CURSOR_SECRET", ""),
+		JWTSecret:        getEnv("JWT_SECRET", ""),
+		AccessTokenTTL:   getEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL:  getEnvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+
+		MediaSigner:              getEnv("MEDIA_SIGNER", MediaSignerPublic),
+		MediaURLTTL:              getEnvDuration("MEDIA_URL_TTL", 15*time.Minute),
+		MediaBaseURL:             getEnv("MEDIA_BASE_URL", ""),
+		MediaStorage:             getEnv("MEDIA_STORAGE", MediaStorageS3),
+		MediaLocalDir:            getEnv("MEDIA_LOCAL_DIR", "./media"),
+		S3BucketName:             getEnv("S3_BUCKET_NAME", "audio-slide-app-assets"),
+		S3Region:                 getEnv("S3_REGION", awsRegion),
+		CloudFrontDomain:         getEnv("CLOUDFRONT_DOMAIN", ""),
+		CloudFrontKeyPairID:      getEnv("CLOUDFRONT_KEY_PAIR_ID", ""),
+		CloudFrontPrivateKeyPath: getEnv("CLOUDFRONT_PRIVATE_KEY_PATH", ""),
+
+		TracingExporter:    getEnv("TRACING_EXPORTER", TracingExporterNone),
+		TracingSampleRatio: getEnvFloat("TRACING_SAMPLE_RATIO", 1),
+		TracingServiceName: getEnv("OTEL_SERVICE_NAME", "audio-slide-app-backend"),
+
+		HealthCheckTimeout:  getEnvDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+		HealthCheckCacheTTL: getEnvDuration("HEALTH_CHECK_CACHE_TTL", 5*time.Second),
+
+		ServerReadHeaderTimeout: getEnvDuration("SERVER_READ_HEADER_TIMEOUT", 5*time.Second),
+		ServerReadTimeout:       getEnvDuration("SERVER_READ_TIMEOUT", 60*time.Second),
+		ServerWriteTimeout:      getEnvDuration("SERVER_WRITE_TIMEOUT", 60*time.Second),
+		ServerIdleTimeout:       getEnvDuration("SERVER_IDLE_TIMEOUT", 120*time.Second),
+		ServerMaxHeaderBytes:    getEnvInt("SERVER_MAX_HEADER_BYTES", 64<<10),
+		ShutdownDrainDelay:      getEnvDuration("SHUTDOWN_DRAIN_DELAY", 5*time.Second),
+		ShutdownTimeout:         getEnvDuration("SHUTDOWN_TIMEOUT", 20*time.Second),
+		TracingShutdownTimeout:  getEnvDuration("TRACING_SHUTDOWN_TIMEOUT", 3*time.Second),
 	}
 }
 
+// Validate 起動はできるが正しく動作しない設定値を検出する（/api/health/ready の config で確認する）
+func (c *Config) Validate() error {
+	var problems []error
+	if c.CategoryCacheTTL < 0 {
+		problems = append(problems, errors.New("CATEGORY_CACHE_TTL must not be negative"))
+	}
+	if c.AccessTokenTTL <= 0 {
+		problems = append(problems, errors.New("ACCESS_TOKEN_TTL must be positive"))
+	}
+	if c.RefreshTokenTTL <= c.AccessTokenTTL {
+		problems = append(problems, errors.New("REFRESH_TOKEN_TTL must be longer than ACCESS_TOKEN_TTL"))
+	}
+	if c.MediaURLTTL <= 0 {
+		problems = append(problems, errors.New("MEDIA_URL_TTL must be positive"))
+	}
+	if c.TracingSampleRatio < 0 || c.TracingSampleRatio > 1 {
+		problems = append(problems, fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1, got %v", c.TracingSampleRatio))
+	}
+	if c.HealthCheckTimeout <= 0 {
+		problems = append(problems, errors.New("HEALTH_CHECK_TIMEOUT must be positive"))
+	}
+	if c.HealthCheckCacheTTL < 0 {
+		problems = append(problems, errors.New("HEALTH_CHECK_CACHE_TTL must not be negative"))
+	}
+	if c.ServerReadHeaderTimeout <= 0 || c.ServerReadTimeout <= 0 || c.ServerWriteTimeout <= 0 || c.ServerIdleTimeout <= 0 {
+		problems = append(problems, errors.New("SERVER_READ_HEADER_TIMEOUT, SERVER_READ_TIMEOUT, SERVER_WRITE_TIMEOUT and SERVER_IDLE_TIMEOUT must be positive"))
+	}
+	if c.ServerMaxHeaderBytes <= 0 {
+		problems = append(problems, errors.New("SERVER_MAX_HEADER_BYTES must be positive"))
+	}
+	if c.ShutdownDrainDelay < 0 || c.ShutdownTimeout <= 0 {
+		problems = append(problems, errors.New("SHUTDOWN_DRAIN_DELAY must not be negative and SHUTDOWN_TIMEOUT must be positive"))
+	}
+	if c.TracingShutdownTimeout <= 0 {
+		problems = append(problems, errors.New("TRACING_SHUTDOWN_TIMEOUT must be positive"))
+	}
+	return errors.Join(problems...)
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
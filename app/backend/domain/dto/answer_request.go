@@ -0,0 +1,6 @@
+package dto
+
+// SubmitAnswerRequest クイズ回答リクエスト
+type SubmitAnswerRequest struct {
+	Answer string `json:"answer" binding:"required"`
+}
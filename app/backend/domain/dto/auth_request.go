@@ -0,0 +1,19 @@
+package dto
+
+// SignUpRequest ユーザー登録リクエスト
+type SignUpRequest struct {
+	Email       string `json:"email" binding:"required"`
+	Password    string `json:"password" binding:"required"`
+	DisplayName string `json:"displayName"`
+}
+
+// LoginRequest ログインリクエスト
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshTokenRequest アクセストークン再発行リクエスト
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
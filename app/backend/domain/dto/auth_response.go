@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"audio-slide-app/domain/model"
+)
+
+// UserResponse パスワードハッシュを除いたユーザー情報
+type UserResponse struct {
+	ID          string     `json:"id"`
+	Email       string     `json:"email"`
+	DisplayName string     `json:"displayName"`
+	Role        model.Role `json:"role"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// AuthResponse サインアップ・ログイン・トークン再発行のレスポンス
+type AuthResponse struct {
+	AccessToken  string        `json:"accessToken"`
+	RefreshToken string        `json:"refreshToken"`
+	TokenType    string        `json:"tokenType"`
+	ExpiresIn    int64         `json:"expiresIn"`
+	User         *UserResponse `json:"user"`
+}
+
+func NewUserResponse(user *model.User) *UserResponse {
+	return &UserResponse{
+		ID:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		Role:        user.EffectiveRole(),
+		CreatedAt:   user.CreatedAt,
+	}
+}
+
+func NewAuthResponse(result *model.AuthResult) *AuthResponse {
+	return &AuthResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    result.Tokens.ExpiresIn,
+		User:         NewUserResponse(result.User),
+	}
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "audio-slide-app/domain/model"
+
+// CategoryRequest カテゴリ作成・更新リクエスト
+type CategoryRequest struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Thumbnail   string `json:"thumbnail"`
+	SortOrder   int    `json:"sortOrder"`
+	// Translations 言語ごとの名前と説明（キーは言語コード）
+	Translations map[string]model.CategoryText `json:"translations"`
+}
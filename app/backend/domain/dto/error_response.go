@@ -5,9 +5,15 @@ type ErrorResponse struct {
 }
 
 type ErrorDetail struct {
-	Code    string `json:"code"`
+	Code    string             `json:"code"`
+	Message string             `json:"message"`
+	Details string             `json:"details,omitempty"`
+	Fields  []FieldErrorDetail `json:"fields,omitempty"`
+}
+
+type FieldErrorDetail struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
 }
 
 func NewErrorResponse(code, message, details string) *ErrorResponse {
@@ -18,4 +24,4 @@ func NewErrorResponse(code, message, details string) *ErrorResponse {
 			Details: details,
 		},
 	}
-}
\ No newline at end of file
+}
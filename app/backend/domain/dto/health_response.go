@@ -4,14 +4,38 @@ import (
 	"time"
 )
 
+const (
+	HealthStatusOK      = "ok"
+	HealthStatusError   = "error"
+	HealthStatusTimeout = "timeout"
+)
+
 type HealthResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
+	Status    string              `json:"status"`
+	Timestamp time.Time           `json:"timestamp"`
+	Checks    []HealthCheckResult `json:"checks,omitempty"`
+}
+
+// HealthCheckResult 依存先ごとの確認結果
+// エラーの詳細は外部に公開せず、ログにのみ出力する
+type HealthCheckResult struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latencyMs"`
 }
 
-func NewHealthResponse() *HealthResponse {
+// NewHealthResponse すべての確認結果が ok の場合のみ ok とする
+func NewHealthResponse(timestamp time.Time, checks []HealthCheckResult) *HealthResponse {
+	status := HealthStatusOK
+	for _, check := range checks {
+		if check.Status != HealthStatusOK {
+			status = HealthStatusError
+		}
+	}
+
 	return &HealthResponse{
-		Status:    "ok",
-		Timestamp: time.Now(),
+		Status:    status,
+		Timestamp: timestamp,
+		Checks:    checks,
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,11 @@
+package dto
+
+// ImportOptions コンテンツ一括インポートの動作モード
+type ImportOptions struct {
+	// DryRun 書き込みを行わず、実行結果のみを返す
+	DryRun bool
+	// Upsert 既存のアイテムを入力内容で上書きする（false の場合は既存アイテムをスキップ）
+	Upsert bool
+	// Prune 入力に含まれないアイテムをテーブルから削除する
+	Prune bool
+}
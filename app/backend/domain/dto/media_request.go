@@ -0,0 +1,11 @@
+package dto
+
+import "io"
+
+// MediaUploadRequest 画像・音声ファイルのアップロードリクエスト（multipart/form-data）
+type MediaUploadRequest struct {
+	// Category 保存先のカテゴリ（images/<category>/ または audio/<category>/ に保存する）
+	Category string
+	File     io.ReadSeeker
+	Size     int64
+}
@@ -0,0 +1,11 @@
+package dto
+
+// MediaUploadResponse アップロードしたファイルのオブジェクトキー
+// Key をそのままクイズの questionImageUrl や audioAssets[].url に指定する
+type MediaUploadResponse struct {
+	Key string `json:"key"`
+	// URL アップロード結果の確認用の期限付き URL
+	URL         string `json:"url"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"time"
+
+	"audio-slide-app/domain/model"
+)
+
+// CategoryProgressResponse カテゴリ単位の習熟状況
+type CategoryProgressResponse struct {
+	Category        string     `json:"category"`
+	TotalQuizzes    int        `json:"totalQuizzes"`
+	AnsweredQuizzes int        `json:"answeredQuizzes"`
+	MasteredQuizzes int        `json:"masteredQuizzes"`
+	Attempts        int        `json:"attempts"`
+	CorrectCount    int        `json:"correctCount"`
+	Accuracy        float64    `json:"accuracy"`
+	Mastery         float64    `json:"mastery"`
+	LastAnsweredAt  *time.Time `json:"lastAnsweredAt,omitempty"`
+}
+
+// QuizProgressResponse 問題ごとの習熟状況
+type QuizProgressResponse struct {
+	QuizID         string    `json:"quizId"`
+	Attempts       int       `json:"attempts"`
+	CorrectCount   int       `json:"correctCount"`
+	Streak         int       `json:"streak"`
+	Mastered       bool      `json:"mastered"`
+	LastCorrect    bool      `json:"lastCorrect"`
+	LastAnsweredAt time.Time `json:"lastAnsweredAt"`
+	DueAt          time.Time `json:"dueAt"`
+}
+
+// ProgressResponse GET /api/me/progress のレスポンス
+type ProgressResponse struct {
+	Categories []*CategoryProgressResponse `json:"categories"`
+}
+
+// CategoryProgressDetailResponse GET /api/me/progress/:category のレスポンス
+type CategoryProgressDetailResponse struct {
+	*CategoryProgressResponse
+	Quizzes []*QuizProgressResponse `json:"quizzes"`
+}
+
+func NewCategoryProgressResponse(progress *model.CategoryProgress) *CategoryProgressResponse {
+	return &CategoryProgressResponse{
+		Category:        progress.Category,
+		TotalQuizzes:    progress.TotalQuizzes,
+		AnsweredQuizzes: progress.AnsweredQuizzes,
+		MasteredQuizzes: progress.MasteredQuizzes,
+		Attempts:        progress.Attempts,
+		CorrectCount:    progress.CorrectCount,
+		Accuracy:        progress.Accuracy(),
+		Mastery:         progress.Mastery(),
+		LastAnsweredAt:  progress.LastAnsweredAt,
+	}
+}
+
+func NewProgressResponse(progresses []*model.CategoryProgress) *ProgressResponse {
+	categories := make([]*CategoryProgressResponse, 0, len(progresses))
+	for _, progress := range progresses {
+		categories = append(categories, NewCategoryProgressResponse(progress))
+	}
+	return &ProgressResponse{
+		Categories: categories,
+	}
+}
+
+func NewCategoryProgressDetailResponse(detail *model.CategoryProgressDetail) *CategoryProgressDetailResponse {
+	quizzes := make([]*QuizProgressResponse, 0, len(detail.Quizzes))
+	for _, progress := range detail.Quizzes {
+		quizzes = append(quizzes, &QuizProgressResponse{
+			QuizID:         progress.QuizID,
+			Attempts:       progress.Attempts,
+			CorrectCount:   progress.CorrectCount,
+			Streak:         progress.Streak,
+			Mastered:       progress.IsMastered(),
+			LastCorrect:    progress.LastCorrect,
+			LastAnsweredAt: progress.LastAnsweredAt,
+			DueAt:          progress.DueAt,
+		})
+	}
+	return &CategoryProgressDetailResponse{
+		CategoryProgressResponse: NewCategoryProgressResponse(detail.Summary),
+		Quizzes:                  quizzes,
+	}
+}
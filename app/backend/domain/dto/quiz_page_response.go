@@ -0,0 +1,16 @@
+package dto
+
+import "audio-slide-app/domain/model"
+
+// QuizPageResponse カテゴリ内のクイズ一覧（ページング）のレスポンス
+type QuizPageResponse struct {
+	Quizzes    []*QuizResponse `json:"quizzes"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+func NewQuizPageResponse(page *model.QuizPage) *QuizPageResponse {
+	return &QuizPageResponse{
+		Quizzes:    NewQuizResponses(page.Quizzes),
+		NextCursor: page.NextCursor,
+	}
+}
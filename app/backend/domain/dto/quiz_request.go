@@ -0,0 +1,37 @@
+package dto
+
+import "audio-slide-app/domain/model"
+
+// QuizRequest クイズ作成・更新（全項目置き換え）リクエスト
+type QuizRequest struct {
+	ID               string   `json:"id"`
+	QuestionImageURL string   `json:"questionImageUrl"`
+	QuestionAudioURL string   `json:"questionAudioUrl"`
+	CorrectAnswer    string   `json:"correctAnswer"`
+	Choices          []string `json:"choices"`
+	Category         string   `json:"category"`
+	Explanation      string   `json:"explanation"`
+	// GenerateDistractors 出題時に同じカテゴリの他のクイズの正解から誤答を補う
+	GenerateDistractors bool `json:"generateDistractors"`
+	// Translations 言語ごとの正解・選択肢・解説（キーは言語コード）
+	Translations map[string]model.QuizText `json:"translations"`
+	// AudioAssets 言語ごとの読み上げや実際の音などの音声の一覧
+	AudioAssets []model.AudioAsset `json:"audioAssets"`
+}
+
+// PatchQuizRequest クイズ部分更新リクエスト
+// 指定されなかった（nilの）項目は現在の値を維持する
+type PatchQuizRequest struct {
+	QuestionImageURL *string   `json:"questionImageUrl"`
+	QuestionAudioURL *string   `json:"questionAudioUrl"`
+	CorrectAnswer    *string   `json:"correctAnswer"`
+	Choices          *[]string `json:"choices"`
+	Category         *string   `json:"category"`
+	Explanation      *string   `json:"explanation"`
+	// GenerateDistractors 出題時に同じカテゴリの他のクイズの正解から誤答を補う
+	GenerateDistractors *bool `json:"generateDistractors"`
+	// Translations 指定した場合は翻訳をすべて置き換える（空のオブジェクトで全削除）
+	Translations *map[string]model.QuizText `json:"translations"`
+	// AudioAssets 指定した場合は音声の一覧をすべて置き換える（空の配列で全削除）
+	AudioAssets *[]model.AudioAsset `json:"audioAssets"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"audio-slide-app/domain/model"
+)
+
+// QuizResponse 出題用のクイズレスポンス
+// 正解と解説は回答後に採点APIから返却するため含めない
+type QuizResponse struct {
+	ID               string   `json:"id"`
+	QuestionImageURL string   `json:"questionImageUrl"`
+	QuestionAudioURL string   `json:"questionAudioUrl"`
+	Choices          []string `json:"choices"`
+	Category         string   `json:"category"`
+	// AudioAssets 音声の一覧（未登録の場合は空の配列）
+	AudioAssets []model.AudioAsset `json:"audioAssets"`
+}
+
+func NewQuizResponse(quiz *model.Quiz) *QuizResponse {
+	return &QuizResponse{
+		ID:               quiz.ID,
+		QuestionImageURL: quiz.QuestionImageURL,
+		QuestionAudioURL: quiz.DefaultAudioURL(),
+		Choices:          quiz.Choices,
+		Category:         quiz.Category,
+		AudioAssets:      audioAssetsOrEmpty(quiz.AudioAssets),
+	}
+}
+
+func NewQuizResponses(quizzes []*model.Quiz) []*QuizResponse {
+	responses := make([]*QuizResponse, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		responses = append(responses, NewQuizResponse(quiz))
+	}
+	return responses
+}
+
+func audioAssetsOrEmpty(assets []model.AudioAsset) []model.AudioAsset {
+	if assets == nil {
+		return []model.AudioAsset{}
+	}
+	return assets
+}
@@ -0,0 +1,15 @@
+package dto
+
+// CreateSessionRequest クイズセッション作成リクエスト
+type CreateSessionRequest struct {
+	Category string `json:"category" binding:"required"`
+	Count    int    `json:"count"`
+	// Choices 1問あたりの選択肢数（省略時はクイズごとのデフォルト）
+	Choices int `json:"choices"`
+}
+
+// SubmitSessionAnswerRequest セッション内のクイズ回答リクエスト
+type SubmitSessionAnswerRequest struct {
+	QuizID string `json:"quizId" binding:"required"`
+	Answer string `json:"answer" binding:"required"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"audio-slide-app/domain/model"
+)
+
+// SessionResponse クイズセッション作成レスポンス
+// questions は固定された出題順で返却する
+type SessionResponse struct {
+	ID        string          `json:"id"`
+	Category  string          `json:"category"`
+	StartedAt time.Time       `json:"startedAt"`
+	Questions []*QuizResponse `json:"questions"`
+}
+
+func NewSessionResponse(session *model.QuizSession, quizzes []*model.Quiz) *SessionResponse {
+	return &SessionResponse{
+		ID:        session.ID,
+		Category:  session.Category,
+		StartedAt: session.StartedAt,
+		Questions: NewQuizResponses(quizzes),
+	}
+}
@@ -0,0 +1,6 @@
+package dto
+
+// UpdateUserRoleRequest ユーザーの役割変更リクエスト
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
@@ -0,0 +1,23 @@
+package model
+
+// AnswerResult クイズの回答に対する採点結果
+type AnswerResult struct {
+	QuizID        string `json:"quizId"`
+	Answer        string `json:"answer"`
+	IsCorrect     bool   `json:"isCorrect"`
+	CorrectAnswer string `json:"correctAnswer"`
+	Explanation   string `json:"explanation"`
+	// Category 学習履歴の記録先（採点時点のクイズのカテゴリ）
+	Category string `json:"-"`
+}
+
+func NewAnswerResult(quiz *Quiz, answer string) *AnswerResult {
+	return &AnswerResult{
+		QuizID:        quiz.ID,
+		Answer:        answer,
+		IsCorrect:     quiz.IsCorrect(answer),
+		CorrectAnswer: quiz.CorrectAnswer,
+		Explanation:   quiz.Explanation,
+		Category:      quiz.Category,
+	}
+}
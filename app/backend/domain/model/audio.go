@@ -0,0 +1,95 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"audio-slide-app/common/locale"
+)
+
+// AudioKind 音声の種類
+type AudioKind string
+
+const (
+	// AudioKindReading 正解の読み上げ（言語ごとに登録する）
+	AudioKindReading AudioKind = "reading"
+	// AudioKindSound 動物の鳴き声などの実際の音（言語によらない）
+	AudioKindSound AudioKind = "sound"
+)
+
+// AudioAsset クイズに紐づく音声1件
+type AudioAsset struct {
+	Kind AudioKind `json:"kind" yaml:"kind" dynamodbav:"kind"`
+	// Language 読み上げの言語（sound の場合は空）
+	Language string `json:"language,omitempty" yaml:"language,omitempty" dynamodbav:"language,omitempty"`
+	// Voice 同じ言語に複数の読み上げがある場合の話者の区別（例: female, male）
+	Voice      string `json:"voice,omitempty" yaml:"voice,omitempty" dynamodbav:"voice,omitempty"`
+	URL        string `json:"url" yaml:"url" dynamodbav:"url"`
+	DurationMs int    `json:"durationMs,omitempty" yaml:"durationMs,omitempty" dynamodbav:"durationMs,omitempty"`
+	MimeType   string `json:"mimeType" yaml:"mimeType" dynamodbav:"mimeType"`
+}
+
+// validateAudioAssets 音声の一覧をチェックする
+func validateAudioAssets(assets []AudioAsset) FieldErrors {
+	var fieldErrs FieldErrors
+
+	seen := make(map[string]bool, len(assets))
+	for i, asset := range assets {
+		field := fmt.Sprintf("audioAssets[%d]", i)
+		prefix := field + "."
+
+		switch asset.Kind {
+		case AudioKindReading:
+			if !locale.IsSupported(asset.Language) {
+				fieldErrs.add(prefix+"language", fmt.Sprintf("must be one of %s for reading", strings.Join(locale.Supported, ", ")))
+			}
+		case AudioKindSound:
+			if asset.Language != "" {
+				fieldErrs.add(prefix+"language", "must be empty for sound")
+			}
+		default:
+			fieldErrs.add(prefix+"kind", fmt.Sprintf("must be '%s' or '%s'", AudioKindReading, AudioKindSound))
+		}
+
+		if asset.URL == "" {
+			fieldErrs.add(prefix+"url", "is required")
+		} else if !isValidMediaRef(asset.URL) {
+			fieldErrs.add(prefix+"url", invalidMediaRefMessage)
+		}
+		if !strings.HasPrefix(asset.MimeType, "audio/") {
+			fieldErrs.add(prefix+"mimeType", "must be an audio MIME type")
+		}
+		if asset.DurationMs < 0 {
+			fieldErrs.add(prefix+"durationMs", "must not be negative")
+		}
+
+		// 同じ種類・言語・話者の音声は1件まで
+		key := strings.Join([]string{string(asset.Kind), asset.Language, asset.Voice}, "#")
+		if seen[key] {
+			fieldErrs.add(field, "is duplicated")
+		}
+		seen[key] = true
+	}
+
+	return fieldErrs
+}
+
+// DefaultAudioURL questionAudioUrl のみを参照する古いクライアント向けの音声 URL
+// questionAudioUrl が未登録の場合は日本語の読み上げ、実際の音、その他の順に音声の一覧から選ぶ
+func (q *Quiz) DefaultAudioURL() string {
+	if q.QuestionAudioURL != "" {
+		return q.QuestionAudioURL
+	}
+	for _, match := range []func(AudioAsset) bool{
+		func(a AudioAsset) bool { return a.Kind == AudioKindReading && a.Language == locale.Default },
+		func(a AudioAsset) bool { return a.Kind == AudioKindSound },
+		func(a AudioAsset) bool { return true },
+	} {
+		for _, asset := range q.AudioAssets {
+			if match(asset) {
+				return asset.URL
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAudioAssets(t *testing.T) {
+	reading := func(lang string) AudioAsset {
+		return AudioAsset{Kind: AudioKindReading, Language: lang, URL: "/audio/animals/lion_" + lang + ".mp3", DurationMs: 1200, MimeType: "audio/mpeg"}
+	}
+	sound := AudioAsset{Kind: AudioKindSound, URL: "https://cdn.example.com/audio/lion.ogg", MimeType: "audio/ogg"}
+
+	tests := []struct {
+		name       string
+		assets     []AudioAsset
+		wantFields []string
+	}{
+		{
+			name:       "正常系_言語ごとの読み上げと実際の音",
+			assets:     []AudioAsset{reading("ja"), reading("en"), sound},
+			wantFields: nil,
+		},
+		{
+			name: "正常系_同じ言語の別の話者",
+			assets: []AudioAsset{
+				{Kind: AudioKindReading, Language: "ja", Voice: "female", URL: "/audio/ja_f.mp3", MimeType: "audio/mpeg"},
+				{Kind: AudioKindReading, Language: "ja", Voice: "male", URL: "/audio/ja_m.mp3", MimeType: "audio/mpeg"},
+			},
+			wantFields: nil,
+		},
+		{
+			name:       "異常系_同じ言語の読み上げの重複",
+			assets:     []AudioAsset{reading("ja"), reading("ja")},
+			wantFields: []string{"audioAssets[1]"},
+		},
+		{
+			name: "異常系_不正な種類と言語",
+			assets: []AudioAsset{
+				{Kind: "video", URL: "/video/lion.mp4", MimeType: "audio/mpeg"},
+				{Kind: AudioKindReading, Language: "fr", URL: "/audio/fr.mp3", MimeType: "audio/mpeg"},
+				{Kind: AudioKindSound, Language: "ja", URL: "/audio/lion.mp3", MimeType: "audio/mpeg"},
+			},
+			wantFields: []string{"audioAssets[0].kind", "audioAssets[1].language", "audioAssets[2].language"},
+		},
+		{
+			name: "異常系_URLとMIMEタイプと再生時間",
+			assets: []AudioAsset{
+				{Kind: AudioKindSound, URL: "audio/lion roar.mp3", DurationMs: -1, MimeType: "image/png"},
+			},
+			wantFields: []string{"audioAssets[0].url", "audioAssets[0].mimeType", "audioAssets[0].durationMs"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fieldErrs := validateAudioAssets(tt.assets)
+
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				fields = append(fields, fieldErr.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestQuiz_DefaultAudioURL(t *testing.T) {
+	tests := []struct {
+		name             string
+		questionAudioURL string
+		assets           []AudioAsset
+		want             string
+	}{
+		{
+			name:             "正常系_questionAudioUrlを優先",
+			questionAudioURL: "/audio/lion.mp3",
+			assets:           []AudioAsset{{Kind: AudioKindReading, Language: "ja", URL: "/audio/lion_ja.mp3"}},
+			want:             "/audio/lion.mp3",
+		},
+		{
+			name: "正常系_日本語の読み上げ",
+			assets: []AudioAsset{
+				{Kind: AudioKindSound, URL: "/audio/lion_roar.mp3"},
+				{Kind: AudioKindReading, Language: "en", URL: "/audio/lion_en.mp3"},
+				{Kind: AudioKindReading, Language: "ja", URL: "/audio/lion_ja.mp3"},
+			},
+			want: "/audio/lion_ja.mp3",
+		},
+		{
+			name: "正常系_日本語の読み上げがない場合は実際の音",
+			assets: []AudioAsset{
+				{Kind: AudioKindReading, Language: "en", URL: "/audio/lion_en.mp3"},
+				{Kind: AudioKindSound, URL: "/audio/lion_roar.mp3"},
+			},
+			want: "/audio/lion_roar.mp3",
+		},
+		{
+			name: "正常系_音声なし",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quiz := NewQuiz("quiz_animal_001", "/images/lion.png", tt.questionAudioURL, "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+			quiz.AudioAssets = tt.assets
+
+			assert.Equal(t, tt.want, quiz.DefaultAudioURL())
+		})
+	}
+}
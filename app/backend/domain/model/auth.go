@@ -0,0 +1,30 @@
+package model
+
+const (
+	// TokenTypeAccess API呼び出しに使用するトークン
+	TokenTypeAccess = "access"
+	// TokenTypeRefresh アクセストークンの再発行に使用するトークン
+	TokenTypeRefresh = "refresh"
+)
+
+// TokenPair ログイン時に発行するトークンの組
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn アクセストークンの有効期間（秒）
+	ExpiresIn int64
+}
+
+// TokenClaims トークンから取り出したユーザー情報
+type TokenClaims struct {
+	UserID string
+	Email  string
+	Role   Role
+	Type   string
+}
+
+// AuthResult サインアップ・ログインの結果
+type AuthResult struct {
+	User   *User
+	Tokens *TokenPair
+}
@@ -1,10 +1,32 @@
 package model
 
+import (
+	"fmt"
+
+	"audio-slide-app/common/locale"
+)
+
+// EntityTypeCategory カテゴリアイテムを entityType-index で識別するための値
+const EntityTypeCategory = "CATEGORY"
+
 type Category struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Thumbnail   string `json:"thumbnail"`
+	ID          string `json:"id" dynamodbav:"id"`
+	Name        string `json:"name" dynamodbav:"name"`
+	Description string `json:"description" dynamodbav:"description"`
+	Thumbnail   string `json:"thumbnail" dynamodbav:"thumbnail"`
+	SortOrder   int    `json:"sortOrder" dynamodbav:"sortOrder"`
+	EntityType  string `json:"-" dynamodbav:"entityType"`
+	PK          string `json:"-" dynamodbav:"PK"`
+	SK          string `json:"-" dynamodbav:"SK"`
+	// Translations 言語ごとの名前と説明（キーは言語コード）。Name と Description は日本語
+	Translations map[string]CategoryText `json:"translations,omitempty" dynamodbav:"translations,omitempty"`
+}
+
+// CategoryText カテゴリの言語ごとの文言
+type CategoryText struct {
+	Name string `json:"name" yaml:"name" dynamodbav:"name"`
+	// Description 省略した場合は日本語の説明を使う
+	Description string `json:"description,omitempty" yaml:"description,omitempty" dynamodbav:"description,omitempty"`
 }
 
 func NewCategory(id, name, description, thumbnail string) *Category {
@@ -13,5 +35,44 @@ func NewCategory(id, name, description, thumbnail string) *Category {
 		Name:        name,
 		Description: description,
 		Thumbnail:   thumbnail,
+		EntityType:  EntityTypeCategory,
+		PK:          "CATEGORY#" + id,
+		SK:          "META",
 	}
-}
\ No newline at end of file
+}
+
+// Localize 指定した言語の文言に差し替えたコピーを返す（翻訳がない場合は日本語のまま）
+func (c *Category) Localize(lang string) *Category {
+	text, ok := c.Translations[lang]
+	if !ok || lang == locale.Default {
+		return c
+	}
+
+	copied := *c
+	copied.Name = text.Name
+	if text.Description != "" {
+		copied.Description = text.Description
+	}
+	return &copied
+}
+
+// ValidateTranslations 翻訳の言語と必須項目をチェックする（問題がなければ nil）
+func (c *Category) ValidateTranslations() FieldErrors {
+	var fieldErrs FieldErrors
+
+	for _, lang := range sortedKeys(c.Translations) {
+		field := fmt.Sprintf("translations.%s", lang)
+		if !locale.IsSupported(lang) || lang == locale.Default {
+			fieldErrs.add(field, "is not a supported translation language")
+			continue
+		}
+		if c.Translations[lang].Name == "" {
+			fieldErrs.add(field+".name", "is required")
+		}
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+	return fieldErrs
+}
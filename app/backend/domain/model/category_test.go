@@ -45,6 +45,35 @@ func TestNewCategory(t *testing.T) {
 			assert.Equal(t, tt.categoryName, category.Name)
 			assert.Equal(t, tt.description, category.Description)
 			assert.Equal(t, tt.thumbnail, category.Thumbnail)
+			assert.Equal(t, EntityTypeCategory, category.EntityType)
+			assert.Equal(t, "CATEGORY#"+tt.id, category.PK)
+			assert.Equal(t, "META", category.SK)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestCategory_Localize(t *testing.T) {
+	category := NewCategory("flags", "国旗", "世界各国の国旗を学習", "")
+	category.Translations = map[string]CategoryText{
+		"en": {Name: "Flags"},
+	}
+
+	tests := []struct {
+		name            string
+		lang            string
+		wantName        string
+		wantDescription string
+	}{
+		{name: "正常系_日本語", lang: "ja", wantName: "国旗", wantDescription: "世界各国の国旗を学習"},
+		{name: "正常系_英語（説明は日本語にフォールバック）", lang: "en", wantName: "Flags", wantDescription: "世界各国の国旗を学習"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localized := category.Localize(tt.lang)
+
+			assert.Equal(t, tt.wantName, localized.Name)
+			assert.Equal(t, tt.wantDescription, localized.Description)
+			assert.Equal(t, "国旗", category.Name)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package model
+
+// ShuffleFunc 選択肢の並べ替えに使う関数（rand.Shuffle と同じシグネチャ）
+type ShuffleFunc func(n int, swap func(i, j int))
+
+// ChoiceCountFor 出題時の選択肢数を決める
+// count が 0 の場合、登録済みの選択肢をすべて使う（誤答を自動生成するクイズは DefaultGeneratedChoiceCount 以上）
+func (q *Quiz) ChoiceCountFor(count int) int {
+	if count > 0 {
+		return count
+	}
+	base := len(q.baseChoices())
+	if q.GenerateDistractors && base < DefaultGeneratedChoiceCount {
+		return DefaultGeneratedChoiceCount
+	}
+	return base
+}
+
+// NeedsDistractors 登録済みの選択肢だけでは count 個に足りないかを判定する
+func (q *Quiz) NeedsDistractors(count int) bool {
+	return len(q.baseChoices()) < q.ChoiceCountFor(count)
+}
+
+// PresentChoices 出題用の選択肢を作成する
+// 正解を必ず含めて count 個（0 の場合は ChoiceCountFor の値）に揃え、順番を並べ替えて返す
+// 足りない分は pool（同じカテゴリの他のクイズの正解）から補い、pool でも足りない場合は少ないまま返す
+func (q *Quiz) PresentChoices(count int, pool []string, shuffle ShuffleFunc) []string {
+	count = q.ChoiceCountFor(count)
+
+	seen := map[string]bool{q.CorrectAnswer: true}
+	distractors := make([]string, 0, count)
+	for _, choice := range q.Choices {
+		if !seen[choice] {
+			seen[choice] = true
+			distractors = append(distractors, choice)
+		}
+	}
+
+	if len(distractors)+1 < count {
+		candidates := make([]string, 0, len(pool))
+		for _, answer := range pool {
+			if answer != "" && !seen[answer] {
+				seen[answer] = true
+				candidates = append(candidates, answer)
+			}
+		}
+		shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+		for _, answer := range candidates {
+			if len(distractors)+1 >= count {
+				break
+			}
+			distractors = append(distractors, answer)
+		}
+	}
+
+	if len(distractors)+1 > count {
+		shuffle(len(distractors), func(i, j int) { distractors[i], distractors[j] = distractors[j], distractors[i] })
+		distractors = distractors[:count-1]
+	}
+
+	choices := append([]string{q.CorrectAnswer}, distractors...)
+	shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+	return choices
+}
+
+// AcceptsAnswer 採点対象の回答かを判定する
+// 誤答を補った選択肢は保存されないため、pool に含まれる回答も受け付ける
+func (q *Quiz) AcceptsAnswer(answer string, pool []string) bool {
+	if q.HasChoice(answer) || q.IsCorrect(answer) {
+		return true
+	}
+	for _, candidate := range pool {
+		if candidate == answer {
+			return true
+		}
+	}
+	return false
+}
+
+// WithChoices 選択肢のみを差し替えたコピーを返す（リポジトリから取得したクイズは変更しない）
+func (q *Quiz) WithChoices(choices []string) *Quiz {
+	copied := *q
+	copied.Choices = choices
+	return &copied
+}
+
+// baseChoices 正解を含む登録済みの選択肢
+func (q *Quiz) baseChoices() []string {
+	if q.HasChoice(q.CorrectAnswer) {
+		return q.Choices
+	}
+	return append([]string{q.CorrectAnswer}, q.Choices...)
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// noShuffle 並べ替えを行わない（結果を固定するため）
+func noShuffle(n int, swap func(i, j int)) {}
+
+// reverseShuffle 並べ替えが行われたことを確認するため逆順にする
+func reverseShuffle(n int, swap func(i, j int)) {
+	for i := 0; i < n/2; i++ {
+		swap(i, n-1-i)
+	}
+}
+
+func TestQuiz_PresentChoices(t *testing.T) {
+	pool := []string{"イタリア", "ドイツ", "スペイン", "日本", "ブラジル"}
+
+	tests := []struct {
+		name                string
+		choices             []string
+		generateDistractors bool
+		count               int
+		shuffle             ShuffleFunc
+		want                []string
+	}{
+		{
+			name:    "正常系_登録済みの選択肢を並べ替える",
+			choices: []string{"イタリア", "フランス", "ドイツ"},
+			count:   0,
+			shuffle: reverseShuffle,
+			want:    []string{"ドイツ", "フランス", "イタリア"},
+		},
+		{
+			name:    "正常系_選択肢数を減らしても正解は残る",
+			choices: []string{"イタリア", "フランス", "ドイツ", "スペイン"},
+			count:   2,
+			shuffle: noShuffle,
+			want:    []string{"イタリア", "フランス"},
+		},
+		{
+			name:    "正常系_指定数に足りない分を補う",
+			choices: []string{"イタリア", "フランス"},
+			count:   4,
+			shuffle: noShuffle,
+			want:    []string{"イタリア", "フランス", "ドイツ", "スペイン"},
+		},
+		{
+			name:                "正常系_正解のみのクイズはデフォルトの数まで補う",
+			choices:             []string{"イタリア"},
+			generateDistractors: true,
+			count:               0,
+			shuffle:             noShuffle,
+			want:                []string{"イタリア", "ドイツ", "スペイン", "日本"},
+		},
+		{
+			name:                "正常系_選択肢が空のクイズ",
+			choices:             nil,
+			generateDistractors: true,
+			count:               3,
+			shuffle:             noShuffle,
+			want:                []string{"イタリア", "ドイツ", "スペイン"},
+		},
+		{
+			name:    "正常系_候補が足りない場合は少ないまま返す",
+			choices: []string{"イタリア", "フランス"},
+			count:   8,
+			shuffle: noShuffle,
+			want:    []string{"イタリア", "フランス", "ドイツ", "スペイン", "日本", "ブラジル"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quiz := NewQuiz("quiz_001", "/images/flags/it.png", "", "イタリア", tt.choices, "flags", "")
+			quiz.GenerateDistractors = tt.generateDistractors
+
+			got := quiz.PresentChoices(tt.count, pool, tt.shuffle)
+
+			assert.Equal(t, tt.want, got)
+			assert.Contains(t, got, quiz.CorrectAnswer)
+		})
+	}
+}
+
+func TestQuiz_PresentChoices_DoesNotModifyQuiz(t *testing.T) {
+	choices := []string{"イタリア", "フランス", "ドイツ"}
+	quiz := NewQuiz("quiz_001", "/images/flags/it.png", "", "イタリア", choices, "flags", "")
+
+	presented := quiz.WithChoices(quiz.PresentChoices(0, nil, reverseShuffle))
+
+	assert.Equal(t, []string{"イタリア", "フランス", "ドイツ"}, quiz.Choices)
+	assert.Equal(t, []string{"ドイツ", "フランス", "イタリア"}, presented.Choices)
+}
+
+func TestQuiz_AcceptsAnswer(t *testing.T) {
+	quiz := NewQuiz("quiz_001", "/images/flags/it.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	pool := []string{"イタリア", "ドイツ"}
+
+	assert.True(t, quiz.AcceptsAnswer("フランス", pool))
+	assert.True(t, quiz.AcceptsAnswer("ドイツ", pool))
+	assert.False(t, quiz.AcceptsAnswer("日本", pool))
+}
@@ -0,0 +1,36 @@
+package model
+
+// ContentSet 一括インポート・エクスポートの対象となるカテゴリとクイズ
+type ContentSet struct {
+	Categories []*Category
+	Quizzes    []*Quiz
+}
+
+// ImportResult エンティティごとのインポート結果（ID一覧）
+type ImportResult struct {
+	Created   []string `json:"created"`
+	Updated   []string `json:"updated"`
+	Unchanged []string `json:"unchanged"`
+	Skipped   []string `json:"skipped"`
+	Deleted   []string `json:"deleted"`
+}
+
+// ImportReport インポートの実行（またはドライラン）結果
+type ImportReport struct {
+	DryRun     bool         `json:"dryRun"`
+	Categories ImportResult `json:"categories"`
+	Quizzes    ImportResult `json:"quizzes"`
+}
+
+// LintIssue チェックで問題が見つかったクイズ
+type LintIssue struct {
+	QuizID   string      `json:"quizId"`
+	Category string      `json:"category"`
+	Errors   FieldErrors `json:"errors"`
+}
+
+// LintReport テーブル全体のクイズをチェックした結果
+type LintReport struct {
+	Checked int         `json:"checked"`
+	Issues  []LintIssue `json:"issues"`
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// objectKeyPattern ストレージのオブジェクトキーに使える文字（英数字・'.'・'_'・'-' を '/' で区切る）
+var objectKeyPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)*$`)
+
+// invalidMediaRefMessage メディアの参照が不正な場合の入力エラー
+const invalidMediaRefMessage = "must be an object key, an http(s) URL or a path starting with '/'"
+
+// IsObjectKey メディアの参照がストレージのオブジェクトキー（例: images/flags/jp.png）かを判定する
+// オブジェクトキーは出題時に期限付きの URL に変換し、URL とパスはそのまま返却する
+func IsObjectKey(ref string) bool {
+	if !objectKeyPattern.MatchString(ref) {
+		return false
+	}
+	for _, segment := range strings.Split(ref, "/") {
+		if segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// IsAbsoluteMediaURL メディアの参照が http(s) の URL（パスではない）かを判定する
+func IsAbsoluteMediaURL(ref string) bool {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isValidMediaRef オブジェクトキー、またはそのまま返却できる URL かを判定する
+func isValidMediaRef(raw string) bool {
+	return IsObjectKey(raw) || isValidMediaURL(raw)
+}
+
+// WithMediaURLs 画像・音声の参照を resolve の結果に差し替えたコピーを返す（リポジトリから取得したクイズは変更しない）
+func (q *Quiz) WithMediaURLs(resolve func(ref string) (string, error)) (*Quiz, error) {
+	copied := *q
+
+	var err error
+	if copied.QuestionImageURL, err = resolveMediaRef(q.QuestionImageURL, resolve); err != nil {
+		return nil, err
+	}
+	if copied.QuestionAudioURL, err = resolveMediaRef(q.QuestionAudioURL, resolve); err != nil {
+		return nil, err
+	}
+	if q.AudioAssets != nil {
+		copied.AudioAssets = make([]AudioAsset, len(q.AudioAssets))
+		for i, asset := range q.AudioAssets {
+			if asset.URL, err = resolveMediaRef(asset.URL, resolve); err != nil {
+				return nil, err
+			}
+			copied.AudioAssets[i] = asset
+		}
+	}
+
+	return &copied, nil
+}
+
+func resolveMediaRef(ref string, resolve func(ref string) (string, error)) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	return resolve(ref)
+}
+
+// MediaType アップロードできるメディアの種類（オブジェクトキーの先頭のディレクトリ）
+type MediaType string
+
+const (
+	MediaTypeImage MediaType = "images"
+	MediaTypeAudio MediaType = "audio"
+)
+
+const (
+	// MaxImageSize 画像ファイルの最大サイズ（5MB）
+	MaxImageSize int64 = 5 << 20
+	// MaxAudioSize 音声ファイルの最大サイズ（10MB）
+	MaxAudioSize int64 = 10 << 20
+)
+
+// mediaFormat MIME タイプごとのメディアの種類と保存時の拡張子
+type mediaFormat struct {
+	mediaType MediaType
+	extension string
+}
+
+// mediaFormats アップロードを許可する MIME タイプ
+var mediaFormats = map[string]mediaFormat{
+	"image/png":  {mediaType: MediaTypeImage, extension: ".png"},
+	"image/jpeg": {mediaType: MediaTypeImage, extension: ".jpg"},
+	"image/gif":  {mediaType: MediaTypeImage, extension: ".gif"},
+	"image/webp": {mediaType: MediaTypeImage, extension: ".webp"},
+	"audio/mpeg": {mediaType: MediaTypeAudio, extension: ".mp3"},
+}
+
+// MediaUpload アップロードされたメディアファイル
+// ContentType はクライアントの申告ではなく、ファイルの内容から判定した MIME タイプ
+type MediaUpload struct {
+	Category    string
+	ContentType string
+	Size        int64
+}
+
+// Validate 保存前のメディアファイルをチェックする
+func (m *MediaUpload) Validate() FieldErrors {
+	var fieldErrs FieldErrors
+
+	if m.Category == "" {
+		fieldErrs.add("category", "is required")
+	} else if strings.Contains(m.Category, "/") || !IsObjectKey(m.Category) {
+		fieldErrs.add("category", "must consist of letters, digits, '.', '_' and '-'")
+	}
+
+	format, ok := mediaFormats[m.ContentType]
+	if !ok {
+		fieldErrs.add("file", fmt.Sprintf("content type '%s' is not allowed (allowed: %s)", m.ContentType, strings.Join(sortedKeys(mediaFormats), ", ")))
+	}
+
+	switch {
+	case m.Size <= 0:
+		fieldErrs.add("file", "must not be empty")
+	case ok && m.Size > format.maxSize():
+		fieldErrs.add("file", fmt.Sprintf("must be at most %d bytes for %s", format.maxSize(), format.mediaType))
+	}
+
+	return fieldErrs
+}
+
+// MediaType MIME タイプに対応するメディアの種類（許可されていない場合は空）
+func (m *MediaUpload) MediaType() MediaType {
+	return mediaFormats[m.ContentType].mediaType
+}
+
+// Key 保存先のオブジェクトキー（例: images/flags/<name>.png）
+// Validate でエラーがないことを確認してから呼び出す
+func (m *MediaUpload) Key(name string) string {
+	format := mediaFormats[m.ContentType]
+	return fmt.Sprintf("%s/%s/%s%s", format.mediaType, m.Category, name, format.extension)
+}
+
+func (f mediaFormat) maxSize() int64 {
+	if f.mediaType == MediaTypeAudio {
+		return MaxAudioSize
+	}
+	return MaxImageSize
+}
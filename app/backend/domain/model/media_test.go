@@ -0,0 +1,131 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsObjectKey(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+		want bool
+	}{
+		{name: "正常系_オブジェクトキー", ref: "images/flags/jp.png", want: true},
+		{name: "正常系_階層なし", ref: "lion.mp3", want: true},
+		{name: "異常系_URL", ref: "https://cdn.example.com/audio/lion.mp3", want: false},
+		{name: "異常系_絶対パス", ref: "/audio/lion.mp3", want: false},
+		{name: "異常系_親ディレクトリ", ref: "audio/../lion.mp3", want: false},
+		{name: "異常系_空の階層", ref: "audio//lion.mp3", want: false},
+		{name: "異常系_空白を含む", ref: "audio/lion roar.mp3", want: false},
+		{name: "異常系_空文字", ref: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsObjectKey(tt.ref))
+		})
+	}
+}
+
+func TestIsAbsoluteMediaURL(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+		want bool
+	}{
+		{name: "正常系_https", ref: "https://audio-slide-app-assets.s3.amazonaws.com/images/flags/jp.png", want: true},
+		{name: "正常系_http", ref: "http://localhost:8080/media/images/flags/jp.png", want: true},
+		{name: "異常系_オブジェクトキー", ref: "images/flags/jp.png", want: false},
+		{name: "異常系_絶対パス", ref: "/images/flags/jp.png", want: false},
+		{name: "異常系_ホストなし", ref: "https:///images/flags/jp.png", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsAbsoluteMediaURL(tt.ref))
+		})
+	}
+}
+
+func TestQuiz_WithMediaURLs(t *testing.T) {
+	quiz := NewQuiz("quiz_flag_001", "images/flags/it.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+	quiz.AudioAssets = []AudioAsset{
+		{Kind: AudioKindReading, Language: "ja", URL: "audio/flags/it_ja.mp3", MimeType: "audio/mpeg"},
+	}
+
+	t.Run("正常系_参照を差し替えたコピーを返す", func(t *testing.T) {
+		resolved, err := quiz.WithMediaURLs(func(ref string) (string, error) {
+			return "https://signed.example.com/" + ref, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://signed.example.com/images/flags/it.png", resolved.QuestionImageURL)
+		assert.Equal(t, "", resolved.QuestionAudioURL)
+		assert.Equal(t, "https://signed.example.com/audio/flags/it_ja.mp3", resolved.AudioAssets[0].URL)
+		// 元のクイズは変更しない
+		assert.Equal(t, "images/flags/it.png", quiz.QuestionImageURL)
+		assert.Equal(t, "audio/flags/it_ja.mp3", quiz.AudioAssets[0].URL)
+	})
+
+	t.Run("異常系_差し替えの失敗", func(t *testing.T) {
+		resolved, err := quiz.WithMediaURLs(func(ref string) (string, error) {
+			return "", errors.New("sign error")
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resolved)
+	})
+}
+
+func TestMediaUpload_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		upload     MediaUpload
+		wantFields []string
+		wantKey    string
+	}{
+		{
+			name:    "正常系_画像",
+			upload:  MediaUpload{Category: "flags", ContentType: "image/png", Size: 1024},
+			wantKey: "images/flags/abc.png",
+		},
+		{
+			name:    "正常系_上限サイズの音声",
+			upload:  MediaUpload{Category: "animals", ContentType: "audio/mpeg", Size: MaxAudioSize},
+			wantKey: "audio/animals/abc.mp3",
+		},
+		{
+			name:       "異常系_画像のサイズ超過",
+			upload:     MediaUpload{Category: "flags", ContentType: "image/jpeg", Size: MaxImageSize + 1},
+			wantFields: []string{"file"},
+		},
+		{
+			name:       "異常系_許可されていないMIMEタイプ",
+			upload:     MediaUpload{Category: "flags", ContentType: "text/html; charset=utf-8", Size: 10},
+			wantFields: []string{"file"},
+		},
+		{
+			name:       "異常系_空のファイルと不正なカテゴリ",
+			upload:     MediaUpload{Category: "../flags", ContentType: "image/png", Size: 0},
+			wantFields: []string{"category", "file"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fieldErrs := tt.upload.Validate()
+
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				fields = append(fields, fieldErr.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+			if tt.wantKey != "" {
+				assert.Equal(t, tt.wantKey, tt.upload.Key("abc"))
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"audio-slide-app/domain/srs"
+)
+
+// MasteryStreak 連続してこの回数正解した問題を習得済みとみなす
+const MasteryStreak = 3
+
+// AnswerRecord 採点された回答1件の履歴（USER#<userId>/ANSWER#<回答日時>#<quizId> に保存）
+// category-id-index / id-index に載らないよう、id/category とは別名の属性で保存する
+type AnswerRecord struct {
+	UserID     string    `json:"-" dynamodbav:"userId"`
+	QuizID     string    `json:"quizId" dynamodbav:"answerQuizId"`
+	Category   string    `json:"category" dynamodbav:"answerCategory"`
+	Answer     string    `json:"answer" dynamodbav:"answer"`
+	IsCorrect  bool      `json:"isCorrect" dynamodbav:"isCorrect"`
+	AnsweredAt time.Time `json:"answeredAt" dynamodbav:"answeredAt"`
+	PK         string    `json:"-" dynamodbav:"PK"`
+	SK         string    `json:"-" dynamodbav:"SK"`
+}
+
+func NewAnswerRecord(userID string, result *AnswerResult, answeredAt time.Time) *AnswerRecord {
+	return &AnswerRecord{
+		UserID:     userID,
+		QuizID:     result.QuizID,
+		Category:   result.Category,
+		Answer:     result.Answer,
+		IsCorrect:  result.IsCorrect,
+		AnsweredAt: answeredAt,
+		PK:         "USER#" + userID,
+		// 同時刻の回答でキーが衝突しないよう quizId を付加する
+		SK: fmt.Sprintf("ANSWER#%s#%s", answeredAt.UTC().Format(time.RFC3339Nano), result.QuizID),
+	}
+}
+
+// QuizProgress ユーザーの問題ごとの習熟状況（USER#<userId>/PROGRESS#<category>#<quizId> に保存）
+type QuizProgress struct {
+	UserID         string    `json:"-" dynamodbav:"userId"`
+	QuizID         string    `json:"quizId" dynamodbav:"progressQuizId"`
+	Category       string    `json:"category" dynamodbav:"progressCategory"`
+	Attempts       int       `json:"attempts" dynamodbav:"attempts"`
+	CorrectCount   int       `json:"correctCount" dynamodbav:"correctCount"`
+	Streak         int       `json:"streak" dynamodbav:"streak"`
+	LastCorrect    bool      `json:"lastCorrect" dynamodbav:"lastCorrect"`
+	LastAnsweredAt time.Time `json:"lastAnsweredAt" dynamodbav:"lastAnsweredAt"`
+	// 復習スケジュール（SM-2）。導入前に記録された習熟状況はゼロ値のため、すぐに復習対象になる
+	EaseFactor   float64   `json:"easeFactor" dynamodbav:"easeFactor"`
+	IntervalDays int       `json:"intervalDays" dynamodbav:"intervalDays"`
+	Repetitions  int       `json:"repetitions" dynamodbav:"repetitions"`
+	DueAt        time.Time `json:"dueAt" dynamodbav:"dueAt"`
+	PK           string    `json:"-" dynamodbav:"PK"`
+	SK           string    `json:"-" dynamodbav:"SK"`
+}
+
+func NewQuizProgress(userID, category, quizID string) *QuizProgress {
+	return &QuizProgress{
+		UserID:   userID,
+		QuizID:   quizID,
+		Category: category,
+		PK:       "USER#" + userID,
+		SK:       QuizProgressSK(category, quizID),
+	}
+}
+
+// QuizProgressSK 問題ごとの習熟状況のソートキー（カテゴリ単位で前方一致検索できるようカテゴリを先に置く）
+func QuizProgressSK(category, quizID string) string {
+	return fmt.Sprintf("PROGRESS#%s#%s", category, quizID)
+}
+
+// Record 採点結果を習熟状況と復習スケジュールに反映する
+func (p *QuizProgress) Record(isCorrect bool, answeredAt time.Time) {
+	p.Attempts++
+	if isCorrect {
+		p.CorrectCount++
+		p.Streak++
+	} else {
+		p.Streak = 0
+	}
+	p.LastCorrect = isCorrect
+	p.LastAnsweredAt = answeredAt
+
+	card := srs.Review(p.Card(), srs.QualityFromAnswer(isCorrect), answeredAt)
+	p.EaseFactor = card.EaseFactor
+	p.IntervalDays = card.IntervalDays
+	p.Repetitions = card.Repetitions
+	p.DueAt = card.Due
+}
+
+// Card 復習スケジュールの計算に使うカードの状態
+func (p *QuizProgress) Card() srs.Card {
+	return srs.Card{
+		EaseFactor:   p.EaseFactor,
+		IntervalDays: p.IntervalDays,
+		Repetitions:  p.Repetitions,
+		Due:          p.DueAt,
+	}
+}
+
+// IsDue 復習の期日を迎えているかを判定する
+func (p *QuizProgress) IsDue(now time.Time) bool {
+	return p.Card().IsDue(now)
+}
+
+// IsMastered 連続正解数が MasteryStreak に達しているかを判定する
+func (p *QuizProgress) IsMastered() bool {
+	return p.Streak >= MasteryStreak
+}
+
+// CategoryProgress カテゴリ単位の習熟状況
+type CategoryProgress struct {
+	Category        string     `json:"category"`
+	TotalQuizzes    int        `json:"totalQuizzes"`
+	AnsweredQuizzes int        `json:"answeredQuizzes"`
+	MasteredQuizzes int        `json:"masteredQuizzes"`
+	Attempts        int        `json:"attempts"`
+	CorrectCount    int        `json:"correctCount"`
+	LastAnsweredAt  *time.Time `json:"lastAnsweredAt,omitempty"`
+}
+
+// NewCategoryProgress 問題ごとの習熟状況をカテゴリ単位に集計する
+func NewCategoryProgress(category string, totalQuizzes int, progresses []*QuizProgress) *CategoryProgress {
+	result := &CategoryProgress{
+		Category:     category,
+		TotalQuizzes: totalQuizzes,
+	}
+
+	for _, p := range progresses {
+		if p.Attempts == 0 {
+			continue
+		}
+		result.AnsweredQuizzes++
+		if p.IsMastered() {
+			result.MasteredQuizzes++
+		}
+		result.Attempts += p.Attempts
+		result.CorrectCount += p.CorrectCount
+		if result.LastAnsweredAt == nil || p.LastAnsweredAt.After(*result.LastAnsweredAt) {
+			lastAnsweredAt := p.LastAnsweredAt
+			result.LastAnsweredAt = &lastAnsweredAt
+		}
+	}
+
+	// 削除済みの問題の履歴が残っている場合でも習熟率が100%を超えないようにする
+	if result.AnsweredQuizzes > result.TotalQuizzes {
+		result.TotalQuizzes = result.AnsweredQuizzes
+	}
+
+	return result
+}
+
+// Accuracy 全回答に対する正解率（未回答の場合は0）
+func (p *CategoryProgress) Accuracy() float64 {
+	if p.Attempts == 0 {
+		return 0
+	}
+	return float64(p.CorrectCount) / float64(p.Attempts)
+}
+
+// Mastery カテゴリ内の問題に対する習得済みの割合（問題がない場合は0）
+func (p *CategoryProgress) Mastery() float64 {
+	if p.TotalQuizzes == 0 {
+		return 0
+	}
+	return float64(p.MasteredQuizzes) / float64(p.TotalQuizzes)
+}
+
+// CategoryProgressDetail カテゴリの習熟状況と問題ごとの内訳
+type CategoryProgressDetail struct {
+	Summary *CategoryProgress
+	Quizzes []*QuizProgress
+}
+
+// SortQuizProgresses 直近に回答した問題から順に並べる
+func SortQuizProgresses(progresses []*QuizProgress) {
+	sort.SliceStable(progresses, func(i, j int) bool {
+		if !progresses[i].LastAnsweredAt.Equal(progresses[j].LastAnsweredAt) {
+			return progresses[i].LastAnsweredAt.After(progresses[j].LastAnsweredAt)
+		}
+		return progresses[i].QuizID < progresses[j].QuizID
+	})
+}
+
+// ReviewQueue 復習モードの出題候補
+type ReviewQueue struct {
+	// DueQuizIDs 期日を迎えた問題（期日の古い順）
+	DueQuizIDs []string
+	// Answered 回答済みの問題（新しい問題の選定から除外する）
+	Answered map[string]bool
+}
+
+// NewReviewQueue 習熟状況から復習モードの出題候補を作成する
+func NewReviewQueue(progresses []*QuizProgress, now time.Time) *ReviewQueue {
+	due := make([]*QuizProgress, 0, len(progresses))
+	answered := make(map[string]bool, len(progresses))
+	for _, p := range progresses {
+		answered[p.QuizID] = true
+		if p.IsDue(now) {
+			due = append(due, p)
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		if !due[i].DueAt.Equal(due[j].DueAt) {
+			return due[i].DueAt.Before(due[j].DueAt)
+		}
+		return due[i].QuizID < due[j].QuizID
+	})
+
+	dueQuizIDs := make([]string, 0, len(due))
+	for _, p := range due {
+		dueQuizIDs = append(dueQuizIDs, p.QuizID)
+	}
+
+	return &ReviewQueue{
+		DueQuizIDs: dueQuizIDs,
+		Answered:   answered,
+	}
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuizProgress_Record(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		answers      []bool
+		wantCorrect  int
+		wantStreak   int
+		wantMastered bool
+	}{
+		{
+			name:         "正常系_連続正解で習得",
+			answers:      []bool{true, true, true},
+			wantCorrect:  3,
+			wantStreak:   3,
+			wantMastered: true,
+		},
+		{
+			name:         "正常系_不正解で連続正解数がリセットされる",
+			answers:      []bool{true, true, true, false},
+			wantCorrect:  3,
+			wantStreak:   0,
+			wantMastered: false,
+		},
+		{
+			name:         "正常系_再度の連続正解",
+			answers:      []bool{false, true, true},
+			wantCorrect:  2,
+			wantStreak:   2,
+			wantMastered: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := NewQuizProgress("user_001", "flags", "quiz_flag_001")
+			for i, isCorrect := range tt.answers {
+				progress.Record(isCorrect, now.Add(time.Duration(i)*time.Minute))
+			}
+
+			assert.Equal(t, len(tt.answers), progress.Attempts)
+			assert.Equal(t, tt.wantCorrect, progress.CorrectCount)
+			assert.Equal(t, tt.wantStreak, progress.Streak)
+			assert.Equal(t, tt.wantMastered, progress.IsMastered())
+			assert.Equal(t, tt.answers[len(tt.answers)-1], progress.LastCorrect)
+			assert.Equal(t, "USER#user_001", progress.PK)
+			assert.Equal(t, "PROGRESS#flags#quiz_flag_001", progress.SK)
+			assert.True(t, progress.DueAt.After(progress.LastAnsweredAt))
+		})
+	}
+}
+
+func TestNewCategoryProgress(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+	mastered := NewQuizProgress("user_001", "flags", "quiz_flag_001")
+	for i := 0; i < MasteryStreak; i++ {
+		mastered.Record(true, now.Add(-time.Hour))
+	}
+	answered := NewQuizProgress("user_001", "flags", "quiz_flag_002")
+	answered.Record(false, now)
+
+	progress := NewCategoryProgress("flags", 4, []*QuizProgress{mastered, answered})
+
+	assert.Equal(t, 4, progress.TotalQuizzes)
+	assert.Equal(t, 2, progress.AnsweredQuizzes)
+	assert.Equal(t, 1, progress.MasteredQuizzes)
+	assert.Equal(t, 0.75, progress.Accuracy())
+	assert.Equal(t, 0.25, progress.Mastery())
+	assert.Equal(t, now, *progress.LastAnsweredAt)
+
+	// 問題が削除されて総数を上回っても習熟率は100%を超えない
+	shrunk := NewCategoryProgress("flags", 1, []*QuizProgress{mastered, answered})
+	assert.Equal(t, 2, shrunk.TotalQuizzes)
+	assert.LessOrEqual(t, shrunk.Mastery(), 1.0)
+
+	empty := NewCategoryProgress("flags", 0, nil)
+	assert.Equal(t, 0.0, empty.Accuracy())
+	assert.Equal(t, 0.0, empty.Mastery())
+	assert.Nil(t, empty.LastAnsweredAt)
+}
+
+func TestNewReviewQueue(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+	overdue := NewQuizProgress("user_001", "flags", "quiz_flag_001")
+	overdue.Record(false, now.AddDate(0, 0, -3))
+	dueToday := NewQuizProgress("user_001", "flags", "quiz_flag_002")
+	dueToday.Record(true, now.AddDate(0, 0, -1))
+	notDue := NewQuizProgress("user_001", "flags", "quiz_flag_003")
+	notDue.Record(true, now)
+	// スケジュール導入前に記録された習熟状況
+	legacy := &QuizProgress{QuizID: "quiz_flag_004", Category: "flags", Attempts: 1, LastAnsweredAt: now}
+
+	queue := NewReviewQueue([]*QuizProgress{notDue, dueToday, legacy, overdue}, now)
+
+	assert.Equal(t, []string{"quiz_flag_004", "quiz_flag_001", "quiz_flag_002"}, queue.DueQuizIDs)
+	assert.Len(t, queue.Answered, 4)
+	assert.True(t, queue.Answered["quiz_flag_003"])
+}
@@ -1,7 +1,21 @@
 package model
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
+
+	"audio-slide-app/common/locale"
+)
+
+const (
+	// MinChoiceCount クイズに必要な選択肢の最小数
+	MinChoiceCount = 2
+	// MaxChoiceCount 出題時に指定できる選択肢の最大数
+	MaxChoiceCount = 8
+	// DefaultGeneratedChoiceCount 誤答を自動生成するクイズの出題時のデフォルトの選択肢数
+	DefaultGeneratedChoiceCount = 4
 )
 
 type Quiz struct {
@@ -16,6 +30,23 @@ type Quiz struct {
 	UpdatedAt        time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
 	PK               string    `json:"-" dynamodbav:"PK"`
 	SK               string    `json:"-" dynamodbav:"SK"`
+	// RandomKey ランダム出題用のソートキー（保存時にリポジトリが割り当てる）
+	RandomKey string `json:"-" dynamodbav:"randomKey,omitempty"`
+	// GenerateDistractors 出題時に同じカテゴリの他のクイズの正解から誤答を補う
+	// 有効な場合、choices は正解のみ（または空）でもよい
+	GenerateDistractors bool `json:"generateDistractors" dynamodbav:"generateDistractors,omitempty"`
+	// Translations 言語ごとの文言（キーは言語コード）。CorrectAnswer などの基本の項目は日本語
+	Translations map[string]QuizText `json:"translations,omitempty" dynamodbav:"translations,omitempty"`
+	// AudioAssets 言語ごとの読み上げや実際の音などの音声の一覧（QuestionAudioURL は古いクライアント向けに残す）
+	AudioAssets []AudioAsset `json:"audioAssets,omitempty" dynamodbav:"audioAssets,omitempty"`
+}
+
+// QuizText クイズの言語ごとの文言
+type QuizText struct {
+	CorrectAnswer string   `json:"correctAnswer" yaml:"correctAnswer" dynamodbav:"correctAnswer"`
+	Choices       []string `json:"choices" yaml:"choices" dynamodbav:"choices"`
+	// Explanation 省略した場合は日本語の解説を使う
+	Explanation string `json:"explanation,omitempty" yaml:"explanation,omitempty" dynamodbav:"explanation,omitempty"`
 }
 
 func NewQuiz(id, questionImageURL, questionAudioURL, correctAnswer string, choices []string, category, explanation string) *Quiz {
@@ -33,4 +64,135 @@ func NewQuiz(id, questionImageURL, questionAudioURL, correctAnswer string, choic
 		PK:               "CATEGORY#" + category,
 		SK:               "QUIZ#" + id,
 	}
-}
\ No newline at end of file
+}
+
+// HasChoice 指定された回答が選択肢に含まれているかを判定する
+func (q *Quiz) HasChoice(answer string) bool {
+	for _, choice := range q.Choices {
+		if choice == answer {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCorrect 指定された回答が正解かどうかを判定する
+func (q *Quiz) IsCorrect(answer string) bool {
+	return q.CorrectAnswer == answer
+}
+
+// Validate クイズの内容をチェックし、問題のある項目をすべて返す（問題がなければ nil）
+func (q *Quiz) Validate() FieldErrors {
+	var fieldErrs FieldErrors
+
+	if q.ID == "" {
+		fieldErrs.add("id", "is required")
+	}
+	if q.Category == "" {
+		fieldErrs.add("category", "is required")
+	}
+
+	if q.QuestionImageURL == "" {
+		fieldErrs.add("questionImageUrl", "is required")
+	} else if !isValidMediaRef(q.QuestionImageURL) {
+		fieldErrs.add("questionImageUrl", invalidMediaRefMessage)
+	}
+	if q.QuestionAudioURL != "" && !isValidMediaRef(q.QuestionAudioURL) {
+		fieldErrs.add("questionAudioUrl", invalidMediaRefMessage)
+	}
+
+	fieldErrs.addAll(validateAudioAssets(q.AudioAssets))
+
+	fieldErrs.addAll(validateQuizText("", QuizText{CorrectAnswer: q.CorrectAnswer, Choices: q.Choices}, q.GenerateDistractors))
+
+	for _, lang := range sortedKeys(q.Translations) {
+		prefix := fmt.Sprintf("translations.%s.", lang)
+		if !locale.IsSupported(lang) || lang == locale.Default {
+			fieldErrs.add(strings.TrimSuffix(prefix, "."), "is not a supported translation language")
+			continue
+		}
+		fieldErrs.addAll(validateQuizText(prefix, q.Translations[lang], q.GenerateDistractors))
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+	return fieldErrs
+}
+
+// validateQuizText 正解と選択肢の組み合わせをチェックする（prefix は翻訳の項目名の接頭辞）
+func validateQuizText(prefix string, text QuizText, generateDistractors bool) FieldErrors {
+	var fieldErrs FieldErrors
+
+	if len(text.Choices) < MinChoiceCount && !generateDistractors {
+		fieldErrs.add(prefix+"choices", fmt.Sprintf("at least %d choices are required", MinChoiceCount))
+	}
+	seen := make(map[string]bool, len(text.Choices))
+	for i, choice := range text.Choices {
+		field := fmt.Sprintf("%schoices[%d]", prefix, i)
+		switch {
+		case strings.TrimSpace(choice) == "":
+			fieldErrs.add(field, "must not be empty")
+		case choice != strings.TrimSpace(choice):
+			fieldErrs.add(field, "must not have leading or trailing spaces")
+		case seen[choice]:
+			fieldErrs.add(field, fmt.Sprintf("'%s' is duplicated", choice))
+		}
+		seen[choice] = true
+	}
+
+	if text.CorrectAnswer == "" {
+		fieldErrs.add(prefix+"correctAnswer", "is required")
+	} else if !seen[text.CorrectAnswer] && !generateDistractors {
+		fieldErrs.add(prefix+"correctAnswer", fmt.Sprintf("'%s' must be one of the choices", text.CorrectAnswer))
+	}
+
+	return fieldErrs
+}
+
+// ContentLanguage 指定した言語で出題する場合に実際に使われる文言の言語（翻訳がない場合は日本語）
+func (q *Quiz) ContentLanguage(lang string) string {
+	if _, ok := q.Translations[lang]; ok {
+		return lang
+	}
+	return locale.Default
+}
+
+// AnswerIn 指定した言語の正解（翻訳がない場合は空文字）
+func (q *Quiz) AnswerIn(lang string) string {
+	if lang == locale.Default {
+		return q.CorrectAnswer
+	}
+	return q.Translations[lang].CorrectAnswer
+}
+
+// Localize 指定した言語の文言に差し替えたコピーを返す（翻訳がない場合は日本語のまま）
+func (q *Quiz) Localize(lang string) *Quiz {
+	if q.ContentLanguage(lang) == locale.Default {
+		return q
+	}
+	text := q.Translations[lang]
+
+	copied := *q
+	copied.CorrectAnswer = text.CorrectAnswer
+	copied.Choices = text.Choices
+	if text.Explanation != "" {
+		copied.Explanation = text.Explanation
+	}
+	return &copied
+}
+
+// isValidMediaURL 絶対URL（http/https）またはサイト内の絶対パスかを判定する
+func isValidMediaURL(raw string) bool {
+	if strings.ContainsAny(raw, " \t\r\n") {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return u.Host == "" && strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(raw, "//")
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
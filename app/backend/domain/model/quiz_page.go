@@ -0,0 +1,8 @@
+package model
+
+// QuizPage ページ単位で取得したクイズ一覧
+// NextCursor は続きを取得するための不透明なカーソル（最後のページでは空）
+type QuizPage struct {
+	Quizzes    []*Quiz
+	NextCursor string
+}
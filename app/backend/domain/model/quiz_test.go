@@ -72,3 +72,230 @@ func TestNewQuiz(t *testing.T) {
 		})
 	}
 }
+
+func TestQuiz_IsCorrect(t *testing.T) {
+	quiz := NewQuiz("quiz_flag_001", "url", "audio", "イタリア", []string{"イタリア", "フランス", "ドイツ", "スペイン"}, "flags", "explanation")
+
+	tests := []struct {
+		name          string
+		answer        string
+		wantCorrect   bool
+		wantHasChoice bool
+	}{
+		{
+			name:          "正常系_正解",
+			answer:        "イタリア",
+			wantCorrect:   true,
+			wantHasChoice: true,
+		},
+		{
+			name:          "正常系_不正解",
+			answer:        "フランス",
+			wantCorrect:   false,
+			wantHasChoice: true,
+		},
+		{
+			name:          "異常系_選択肢に存在しない回答",
+			answer:        "日本",
+			wantCorrect:   false,
+			wantHasChoice: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantCorrect, quiz.IsCorrect(tt.answer))
+			assert.Equal(t, tt.wantHasChoice, quiz.HasChoice(tt.answer))
+		})
+	}
+}
+
+func TestQuiz_Validate(t *testing.T) {
+	validQuiz := func() *Quiz {
+		return NewQuiz(
+			"quiz_flag_001",
+			"https://example.com/flags/italy.svg",
+			"/audio/flags/italy.mp3",
+			"イタリア",
+			[]string{"イタリア", "フランス", "ドイツ", "スペイン"},
+			"flags",
+			"explanation",
+		)
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(q *Quiz)
+		wantFields []string
+	}{
+		{
+			name:       "正常系",
+			modify:     func(q *Quiz) {},
+			wantFields: nil,
+		},
+		{
+			name:       "正常系_音声URLなし",
+			modify:     func(q *Quiz) { q.QuestionAudioURL = "" },
+			wantFields: nil,
+		},
+		{
+			name: "正常系_オブジェクトキー",
+			modify: func(q *Quiz) {
+				q.QuestionImageURL = "images/flags/it.png"
+				q.QuestionAudioURL = "audio/flags/it.mp3"
+			},
+			wantFields: nil,
+		},
+		{
+			name: "正常系_誤答の自動生成で正解のみ",
+			modify: func(q *Quiz) {
+				q.GenerateDistractors = true
+				q.Choices = []string{"イタリア"}
+			},
+			wantFields: nil,
+		},
+		{
+			name: "正常系_誤答の自動生成で選択肢なし",
+			modify: func(q *Quiz) {
+				q.GenerateDistractors = true
+				q.Choices = nil
+			},
+			wantFields: nil,
+		},
+		{
+			name:       "異常系_正解が選択肢に含まれない",
+			modify:     func(q *Quiz) { q.CorrectAnswer = "イタリヤ" },
+			wantFields: []string{"correctAnswer"},
+		},
+		{
+			name:       "異常系_選択肢の重複",
+			modify:     func(q *Quiz) { q.Choices = []string{"イタリア", "フランス", "イタリア"} },
+			wantFields: []string{"choices[2]"},
+		},
+		{
+			name:       "異常系_空の選択肢と前後の空白",
+			modify:     func(q *Quiz) { q.Choices = []string{"イタリア", " ", "フランス "} },
+			wantFields: []string{"choices[1]", "choices[2]"},
+		},
+		{
+			name:       "異常系_選択肢が足りない",
+			modify:     func(q *Quiz) { q.Choices = []string{"イタリア"} },
+			wantFields: []string{"choices"},
+		},
+		{
+			name: "正常系_英語の翻訳",
+			modify: func(q *Quiz) {
+				q.Translations = map[string]QuizText{
+					"en": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France", "Germany", "Spain"}},
+				}
+			},
+			wantFields: nil,
+		},
+		{
+			name: "異常系_翻訳の正解が選択肢に含まれない",
+			modify: func(q *Quiz) {
+				q.Translations = map[string]QuizText{
+					"en": {CorrectAnswer: "Italia", Choices: []string{"Italy", "France"}},
+				}
+			},
+			wantFields: []string{"translations.en.correctAnswer"},
+		},
+		{
+			name: "異常系_未対応の翻訳言語",
+			modify: func(q *Quiz) {
+				q.Translations = map[string]QuizText{
+					"ja": {CorrectAnswer: "イタリア", Choices: []string{"イタリア", "フランス"}},
+					"xx": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France"}},
+				}
+			},
+			wantFields: []string{"translations.ja", "translations.xx"},
+		},
+		{
+			name: "異常系_不正なURL",
+			modify: func(q *Quiz) {
+				q.QuestionImageURL = "ftp://example.com/italy.svg"
+				q.QuestionAudioURL = "audio/../italy.mp3"
+			},
+			wantFields: []string{"questionImageUrl", "questionAudioUrl"},
+		},
+		{
+			name: "異常系_必須項目の欠落",
+			modify: func(q *Quiz) {
+				q.ID = ""
+				q.Category = ""
+				q.QuestionImageURL = ""
+				q.CorrectAnswer = ""
+			},
+			wantFields: []string{"id", "category", "questionImageUrl", "correctAnswer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quiz := validQuiz()
+			tt.modify(quiz)
+
+			fieldErrs := quiz.Validate()
+
+			if tt.wantFields == nil {
+				assert.Nil(t, fieldErrs)
+				return
+			}
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				fields = append(fields, fieldErr.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+			assert.Error(t, fieldErrs)
+		})
+	}
+}
+
+func TestQuiz_Localize(t *testing.T) {
+	quiz := NewQuiz("quiz_001", "/images/flags/it.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", "解説")
+	quiz.Translations = map[string]QuizText{
+		"en": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France"}},
+	}
+
+	tests := []struct {
+		name            string
+		lang            string
+		wantAnswer      string
+		wantChoices     []string
+		wantExplanation string
+	}{
+		{
+			name:            "正常系_日本語",
+			lang:            "ja",
+			wantAnswer:      "イタリア",
+			wantChoices:     []string{"イタリア", "フランス"},
+			wantExplanation: "解説",
+		},
+		{
+			name:            "正常系_英語（解説は日本語にフォールバック）",
+			lang:            "en",
+			wantAnswer:      "Italy",
+			wantChoices:     []string{"Italy", "France"},
+			wantExplanation: "解説",
+		},
+		{
+			name:            "正常系_翻訳のない言語は日本語",
+			lang:            "fr",
+			wantAnswer:      "イタリア",
+			wantChoices:     []string{"イタリア", "フランス"},
+			wantExplanation: "解説",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localized := quiz.Localize(tt.lang)
+
+			assert.Equal(t, tt.wantAnswer, localized.CorrectAnswer)
+			assert.Equal(t, tt.wantChoices, localized.Choices)
+			assert.Equal(t, tt.wantExplanation, localized.Explanation)
+			// 元のクイズは変更しない
+			assert.Equal(t, "イタリア", quiz.CorrectAnswer)
+		})
+	}
+}
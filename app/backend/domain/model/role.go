@@ -0,0 +1,44 @@
+package model
+
+// Role ユーザーの役割
+type Role string
+
+const (
+	// RoleLearner クイズに回答する学習者（サインアップ時のデフォルト）
+	RoleLearner Role = "learner"
+	// RoleTeacher クイズを作成・編集できる先生
+	RoleTeacher Role = "teacher"
+	// RoleAdmin カテゴリやユーザーを含むすべてを管理できる管理者
+	RoleAdmin Role = "admin"
+)
+
+// Permission 役割に応じて許可される操作
+type Permission string
+
+const (
+	PermissionWriteQuizzes    Permission = "quizzes:write"
+	PermissionWriteCategories Permission = "categories:write"
+	PermissionManageUsers     Permission = "users:manage"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleLearner: {},
+	RoleTeacher: {PermissionWriteQuizzes},
+	RoleAdmin:   {PermissionWriteQuizzes, PermissionWriteCategories, PermissionManageUsers},
+}
+
+// IsValid 定義済みの役割かを判定する
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can 役割が指定された操作を許可されているかを判定する
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Can(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       Role
+		permission Permission
+		want       bool
+	}{
+		{name: "正常系_学習者はクイズを編集できない", role: RoleLearner, permission: PermissionWriteQuizzes, want: false},
+		{name: "正常系_先生はクイズを編集できる", role: RoleTeacher, permission: PermissionWriteQuizzes, want: true},
+		{name: "正常系_先生はカテゴリを編集できない", role: RoleTeacher, permission: PermissionWriteCategories, want: false},
+		{name: "正常系_管理者はカテゴリを編集できる", role: RoleAdmin, permission: PermissionWriteCategories, want: true},
+		{name: "正常系_管理者はユーザーを管理できる", role: RoleAdmin, permission: PermissionManageUsers, want: true},
+		{name: "異常系_未定義の役割", role: Role("owner"), permission: PermissionWriteQuizzes, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.Can(tt.permission))
+		})
+	}
+}
+
+func TestUser_EffectiveRole(t *testing.T) {
+	user := NewUser("user_001", "taro@example.com", "", "", time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC))
+	assert.Equal(t, RoleLearner, user.EffectiveRole())
+
+	// 役割導入前に登録されたユーザーは学習者として扱う
+	user.Role = ""
+	assert.Equal(t, RoleLearner, user.EffectiveRole())
+
+	user.Role = RoleTeacher
+	assert.Equal(t, RoleTeacher, user.EffectiveRole())
+}
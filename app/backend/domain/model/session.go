@@ -0,0 +1,152 @@
+package model
+
+import (
+	"time"
+)
+
+// SessionTTL セッションの保持期間（DynamoDBのTTLで自動削除される）
+const SessionTTL = 24 * time.Hour
+
+// SessionAnswer セッション内で回答された1問分の記録
+type SessionAnswer struct {
+	QuizID        string    `json:"quizId" dynamodbav:"quizId"`
+	Answer        string    `json:"answer" dynamodbav:"answer"`
+	IsCorrect     bool      `json:"isCorrect" dynamodbav:"isCorrect"`
+	CorrectAnswer string    `json:"correctAnswer" dynamodbav:"correctAnswer"`
+	AnsweredAt    time.Time `json:"answeredAt" dynamodbav:"answeredAt"`
+}
+
+// QuizSession 出題順と回答状況を保持するクイズセッション
+// category-id-index に載らないよう、id/category とは別名の属性で保存する
+// Version は保存のたびに1つ進め、同時回答で先に保存された回答を上書きしないための楽観ロックに使う
+// Language は出題時の言語で、回答はリクエストの言語ではなくこの言語の文言で採点する
+type QuizSession struct {
+	ID        string          `json:"id" dynamodbav:"sessionId"`
+	Category  string          `json:"category" dynamodbav:"sessionCategory"`
+	Language  string          `json:"-" dynamodbav:"language"`
+	QuizIDs   []string        `json:"quizIds" dynamodbav:"quizIds"`
+	Answers   []SessionAnswer `json:"answers" dynamodbav:"answers"`
+	StartedAt time.Time       `json:"startedAt" dynamodbav:"startedAt"`
+	UpdatedAt time.Time       `json:"updatedAt" dynamodbav:"updatedAt"`
+	ExpiresAt int64           `json:"-" dynamodbav:"expiresAt"`
+	Version   int             `json:"-" dynamodbav:"version"`
+	PK        string          `json:"-" dynamodbav:"PK"`
+	SK        string          `json:"-" dynamodbav:"SK"`
+}
+
+func NewQuizSession(id, category, language string, quizIDs []string, now time.Time) *QuizSession {
+	return &QuizSession{
+		ID:        id,
+		Category:  category,
+		Language:  language,
+		QuizIDs:   quizIDs,
+		Answers:   []SessionAnswer{},
+		StartedAt: now,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(SessionTTL).Unix(),
+		PK:        "SESSION#" + id,
+		SK:        "META",
+	}
+}
+
+// IsExpired 保持期間を過ぎたかを判定する
+// DynamoDB の TTL による削除は即時ではないため、期限切れの項目が取得されることがある
+func (s *QuizSession) IsExpired(now time.Time) bool {
+	return now.Unix() >= s.ExpiresAt
+}
+
+// HasQuiz 指定されたクイズがセッションの出題対象かを判定する
+func (s *QuizSession) HasQuiz(quizID string) bool {
+	for _, id := range s.QuizIDs {
+		if id == quizID {
+			return true
+		}
+	}
+	return false
+}
+
+// FindAnswer 指定されたクイズの回答記録を返す（未回答の場合はnil）
+func (s *QuizSession) FindAnswer(quizID string) *SessionAnswer {
+	for i := range s.Answers {
+		if s.Answers[i].QuizID == quizID {
+			return &s.Answers[i]
+		}
+	}
+	return nil
+}
+
+// RecordAnswer 採点結果をセッションに記録する
+func (s *QuizSession) RecordAnswer(result *AnswerResult, answeredAt time.Time) {
+	s.Answers = append(s.Answers, SessionAnswer{
+		QuizID:        result.QuizID,
+		Answer:        result.Answer,
+		IsCorrect:     result.IsCorrect,
+		CorrectAnswer: result.CorrectAnswer,
+		AnsweredAt:    answeredAt,
+	})
+	s.UpdatedAt = answeredAt
+}
+
+// IsCompleted 全問回答済みかを判定する
+func (s *QuizSession) IsCompleted() bool {
+	return len(s.Answers) >= len(s.QuizIDs)
+}
+
+// Result セッションの集計結果を生成する
+func (s *QuizSession) Result() *SessionResult {
+	result := &SessionResult{
+		SessionID: s.ID,
+		Category:  s.Category,
+		Total:     len(s.QuizIDs),
+		Answered:  len(s.Answers),
+		Completed: s.IsCompleted(),
+		StartedAt: s.StartedAt,
+		Results:   make([]QuestionResult, 0, len(s.QuizIDs)),
+	}
+
+	var lastAnsweredAt time.Time
+	for _, quizID := range s.QuizIDs {
+		questionResult := QuestionResult{QuizID: quizID}
+		if answer := s.FindAnswer(quizID); answer != nil {
+			questionResult.Answered = true
+			questionResult.Answer = answer.Answer
+			questionResult.IsCorrect = answer.IsCorrect
+			questionResult.CorrectAnswer = answer.CorrectAnswer
+			if answer.IsCorrect {
+				result.Score++
+			}
+			if answer.AnsweredAt.After(lastAnsweredAt) {
+				lastAnsweredAt = answer.AnsweredAt
+			}
+		}
+		result.Results = append(result.Results, questionResult)
+	}
+
+	if !lastAnsweredAt.IsZero() {
+		result.TimeTakenMs = lastAnsweredAt.Sub(s.StartedAt).Milliseconds()
+	}
+
+	return result
+}
+
+// SessionResult セッションの最終スコアと問題ごとの結果
+type SessionResult struct {
+	SessionID   string           `json:"sessionId"`
+	Category    string           `json:"category"`
+	Score       int              `json:"score"`
+	Total       int              `json:"total"`
+	Answered    int              `json:"answered"`
+	Completed   bool             `json:"completed"`
+	StartedAt   time.Time        `json:"startedAt"`
+	TimeTakenMs int64            `json:"timeTakenMs"`
+	Results     []QuestionResult `json:"results"`
+}
+
+// QuestionResult 問題ごとの回答結果
+type QuestionResult struct {
+	QuizID        string `json:"quizId"`
+	Answered      bool   `json:"answered"`
+	Answer        string `json:"answer,omitempty"`
+	IsCorrect     bool   `json:"isCorrect"`
+	CorrectAnswer string `json:"correctAnswer,omitempty"`
+}
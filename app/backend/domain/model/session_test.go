@@ -0,0 +1,91 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuizSession(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	session := NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001", "quiz_flag_002"}, now)
+
+	assert.Equal(t, "session_001", session.ID)
+	assert.Equal(t, "flags", session.Category)
+	assert.Equal(t, "ja", session.Language)
+	assert.Equal(t, []string{"quiz_flag_001", "quiz_flag_002"}, session.QuizIDs)
+	assert.Empty(t, session.Answers)
+	assert.Equal(t, now, session.StartedAt)
+	assert.Equal(t, now.Add(SessionTTL).Unix(), session.ExpiresAt)
+	assert.Equal(t, "SESSION#session_001", session.PK)
+	assert.Equal(t, "META", session.SK)
+}
+
+func TestQuizSession_Result(t *testing.T) {
+	startedAt := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	quizIDs := []string{"quiz_flag_001", "quiz_flag_002", "quiz_flag_003"}
+
+	tests := []struct {
+		name          string
+		answers       []*AnswerResult
+		wantScore     int
+		wantAnswered  int
+		wantCompleted bool
+		wantTimeTaken int64
+	}{
+		{
+			name:          "正常系_未回答",
+			answers:       nil,
+			wantScore:     0,
+			wantAnswered:  0,
+			wantCompleted: false,
+			wantTimeTaken: 0,
+		},
+		{
+			name: "正常系_途中まで回答",
+			answers: []*AnswerResult{
+				{QuizID: "quiz_flag_001", Answer: "日本", IsCorrect: true, CorrectAnswer: "日本"},
+			},
+			wantScore:     1,
+			wantAnswered:  1,
+			wantCompleted: false,
+			wantTimeTaken: 10000,
+		},
+		{
+			name: "正常系_全問回答",
+			answers: []*AnswerResult{
+				{QuizID: "quiz_flag_002", Answer: "韓国", IsCorrect: false, CorrectAnswer: "中国"},
+				{QuizID: "quiz_flag_001", Answer: "日本", IsCorrect: true, CorrectAnswer: "日本"},
+				{QuizID: "quiz_flag_003", Answer: "タイ", IsCorrect: true, CorrectAnswer: "タイ"},
+			},
+			wantScore:     2,
+			wantAnswered:  3,
+			wantCompleted: true,
+			wantTimeTaken: 30000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := NewQuizSession("session_001", "flags", "ja", quizIDs, startedAt)
+			for i, answer := range tt.answers {
+				session.RecordAnswer(answer, startedAt.Add(time.Duration(i+1)*10*time.Second))
+			}
+
+			result := session.Result()
+
+			assert.Equal(t, "session_001", result.SessionID)
+			assert.Equal(t, tt.wantScore, result.Score)
+			assert.Equal(t, len(quizIDs), result.Total)
+			assert.Equal(t, tt.wantAnswered, result.Answered)
+			assert.Equal(t, tt.wantCompleted, result.Completed)
+			assert.Equal(t, tt.wantTimeTaken, result.TimeTakenMs)
+			// 問題ごとの結果は出題順で返却される
+			assert.Len(t, result.Results, len(quizIDs))
+			for i, quizID := range quizIDs {
+				assert.Equal(t, quizID, result.Results[i].QuizID)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// User 学習者のアカウント
+// category-id-index / id-index に載らないよう、id とは別名の属性で保存する
+type User struct {
+	ID           string    `json:"id" dynamodbav:"userId"`
+	Email        string    `json:"email" dynamodbav:"email"`
+	DisplayName  string    `json:"displayName" dynamodbav:"displayName"`
+	PasswordHash string    `json:"-" dynamodbav:"passwordHash"`
+	Role         Role      `json:"role" dynamodbav:"role"`
+	CreatedAt    time.Time `json:"createdAt" dynamodbav:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt" dynamodbav:"updatedAt"`
+	PK           string    `json:"-" dynamodbav:"PK"`
+	SK           string    `json:"-" dynamodbav:"SK"`
+}
+
+func NewUser(id, email, displayName, passwordHash string, now time.Time) *User {
+	return &User{
+		ID:           id,
+		Email:        NormalizeEmail(email),
+		DisplayName:  displayName,
+		PasswordHash: passwordHash,
+		Role:         RoleLearner,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		PK:           "USER#" + id,
+		SK:           "PROFILE",
+	}
+}
+
+// EffectiveRole 役割が未設定（役割導入前に登録）のユーザーは学習者として扱う
+func (u *User) EffectiveRole() Role {
+	if u.Role == "" {
+		return RoleLearner
+	}
+	return u.Role
+}
+
+// NormalizeEmail 大文字小文字の違いで別アカウントにならないようメールアドレスを正規化する
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldError 項目単位の入力エラー
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors 入力チェックで見つかったエラーの一覧
+type FieldErrors []FieldError
+
+func (e FieldErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		messages = append(messages, fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Message))
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *FieldErrors) add(field, message string) {
+	*e = append(*e, FieldError{Field: field, Message: message})
+}
+
+func (e *FieldErrors) addAll(fieldErrs FieldErrors) {
+	*e = append(*e, fieldErrs...)
+}
+
+// sortedKeys エラーの順序が毎回同じになるよう、マップのキーを昇順で返す
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -10,4 +10,13 @@ import (
 
 type ICategoryRepository interface {
 	GetCategoriesToData(ctx context.Context) ([]*model.Category, error)
+	GetCategoryByIDToData(ctx context.Context, id string) (*model.Category, error)
+	Create(ctx context.Context, category *model.Category) error
+	Update(ctx context.Context, category *model.Category) error
+	Delete(ctx context.Context, id string) error
+	// MarkDeleting 削除中の印を付け、以降のクイズの登録・カテゴリ間の移動・カテゴリの更新を拒否させる
+	MarkDeleting(ctx context.Context, id string) error
+	// UnmarkDeleting 削除を取りやめた場合に削除中の印を外す
+	UnmarkDeleting(ctx context.Context, id string) error
+	BatchPut(ctx context.Context, categories []*model.Category) error
 }
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/repository/mock_$GOFILE -package=mock_repository
+
+package repository
+
+import (
+	"context"
+
+	"audio-slide-app/domain/model"
+)
+
+type IProgressRepository interface {
+	// GetQuizProgressToData 未回答の問題の場合は EC002 を返す
+	GetQuizProgressToData(ctx context.Context, userID, category, quizID string) (*model.QuizProgress, error)
+	// GetQuizProgressesToData category が空の場合は全カテゴリの習熟状況を返す
+	GetQuizProgressesToData(ctx context.Context, userID, category string) ([]*model.QuizProgress, error)
+	// SaveAnswer 回答履歴と更新後の習熟状況を同一トランザクションで書き込む
+	// 回答数・正解数・連続正解数は progress の値ではなく record の採点結果を保存済みの値に加算する
+	SaveAnswer(ctx context.Context, record *model.AnswerRecord, progress *model.QuizProgress) error
+}
@@ -10,5 +10,21 @@ import (
 
 type IQuizRepository interface {
 	GetQuizzesByCategoryToData(ctx context.Context, category string, count int) ([]*model.Quiz, error)
+	// GetQuizPageByCategoryToData startKey の続きから最大 limit 件を取得し、続きがあれば次の開始位置を返す
+	GetQuizPageByCategoryToData(ctx context.Context, category string, limit int, startKey map[string]string) ([]*model.Quiz, map[string]string, error)
 	GetQuizByIDToData(ctx context.Context, id string) (*model.Quiz, error)
+	// GetQuizzesByIDsToData カテゴリ内の指定したIDのクイズをまとめて取得し、ids の順で返す（見つからないIDは含まない）
+	GetQuizzesByIDsToData(ctx context.Context, category string, ids []string) ([]*model.Quiz, error)
+	Create(ctx context.Context, quiz *model.Quiz) error
+	Update(ctx context.Context, quiz *model.Quiz) error
+	Delete(ctx context.Context, id string) error
+	CountQuizzesByCategoryToData(ctx context.Context, category string) (int, error)
+	// CountQuizzesPerCategoryToData 全カテゴリのクイズ数をカテゴリIDごとに返す（クイズのないカテゴリは含まない）
+	CountQuizzesPerCategoryToData(ctx context.Context) (map[string]int, error)
+	// GetCorrectAnswersByCategoryToData カテゴリ内のクイズの指定した言語の正解を重複なしで返す（誤答の生成に使用）
+	GetCorrectAnswersByCategoryToData(ctx context.Context, category, lang string) ([]string, error)
+	DeleteByCategory(ctx context.Context, category string) error
+	GetAllQuizzesToData(ctx context.Context) ([]*model.Quiz, error)
+	BatchPut(ctx context.Context, quizzes []*model.Quiz) error
+	BatchDelete(ctx context.Context, quizzes []*model.Quiz) error
 }
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/repository/mock_$GOFILE -package=mock_repository
+
+package repository
+
+import (
+	"context"
+
+	"audio-slide-app/domain/model"
+)
+
+type ISessionRepository interface {
+	GetSessionByIDToData(ctx context.Context, id string) (*model.QuizSession, error)
+	CreateSession(ctx context.Context, session *model.QuizSession) error
+	UpdateSession(ctx context.Context, session *model.QuizSession) error
+}
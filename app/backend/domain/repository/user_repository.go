@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/repository/mock_$GOFILE -package=mock_repository
+
+package repository
+
+import (
+	"context"
+
+	"audio-slide-app/domain/model"
+)
+
+type IUserRepository interface {
+	GetUserByIDToData(ctx context.Context, id string) (*model.User, error)
+	GetUserByEmailToData(ctx context.Context, email string) (*model.User, error)
+	// CreateUser メールアドレスが登録済みの場合は EC001 を返す
+	CreateUser(ctx context.Context, user *model.User) error
+	// UpdateUser ユーザーが存在しない場合は EC002 を返す
+	UpdateUser(ctx context.Context, user *model.User) error
+}
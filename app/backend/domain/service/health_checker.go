@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/service/mock_$GOFILE -package=mock_service
+
+package service
+
+import "context"
+
+// IHealthChecker API サーバーが依存する DynamoDB・S3・設定などが利用できるかを確認する
+type IHealthChecker interface {
+	// Name ヘルスチェックのレスポンスに表示する名前
+	Name() string
+	// Check 利用できない場合はエラーを返す（context のタイムアウトに従う）
+	Check(ctx context.Context) error
+}
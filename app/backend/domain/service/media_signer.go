@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/service/mock_$GOFILE -package=mock_service
+
+package service
+
+import (
+	"context"
+)
+
+// IMediaSigner ストレージのオブジェクトキーから期限付きの取得用 URL を発行する
+type IMediaSigner interface {
+	SignURL(ctx context.Context, key string) (string, error)
+	// ObjectKeyFromURL 署名対象のバケットを指す URL（オブジェクトキーの導入前に保存された公開 URL）からオブジェクトキーを取り出す
+	ObjectKeyFromURL(rawURL string) (string, bool)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/service/mock_$GOFILE -package=mock_service
+
+package service
+
+import (
+	"context"
+	"io"
+)
+
+// IMediaStorage 画像・音声ファイルをオブジェクトキーで保存する
+type IMediaStorage interface {
+	// Put 同じキーのファイルがある場合は上書きする
+	Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error
+}
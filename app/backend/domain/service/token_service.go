@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mocks/service/mock_$GOFILE -package=mock_service
+
+package service
+
+import (
+	"audio-slide-app/domain/model"
+)
+
+// ITokenService アクセストークン・リフレッシュトークンの発行と検証
+type ITokenService interface {
+	IssueTokens(user *model.User) (*model.TokenPair, error)
+	// ParseToken 署名と有効期限を検証し、指定した種別のトークンであればクレームを返す
+	ParseToken(token, tokenType string) (*model.TokenClaims, error)
+}
@@ -0,0 +1,93 @@
+// Package srs 間隔反復（SM-2）による復習スケジュールの計算
+// 永続化や時刻の取得は行わず、現在のカードの状態と回答の評価から次の状態を返す
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultEaseFactor 初回の回答前のカードの易しさ
+	DefaultEaseFactor = 2.5
+	// MinEaseFactor 易しさの下限（これ以上下がると出題間隔が伸びなくなる）
+	MinEaseFactor = 1.3
+	// MaxIntervalDays 出題間隔の上限（日数）
+	MaxIntervalDays = 365
+)
+
+// Quality 回答の評価（SM-2 の 0〜5 段階、3 以上を正解とみなす）
+type Quality int
+
+const (
+	QualityBlackout  Quality = 0
+	QualityIncorrect Quality = 2
+	QualityCorrect   Quality = 4
+	QualityPerfect   Quality = 5
+)
+
+// passingQuality この評価以上を正解として出題間隔を伸ばす
+const passingQuality Quality = 3
+
+// QualityFromAnswer 正誤のみの回答を SM-2 の評価に変換する
+func QualityFromAnswer(isCorrect bool) Quality {
+	if isCorrect {
+		return QualityCorrect
+	}
+	return QualityIncorrect
+}
+
+// Card 1問分の復習スケジュール
+// ゼロ値は未回答のカード（易しさは DefaultEaseFactor として扱い、すぐに出題対象になる）
+type Card struct {
+	EaseFactor   float64
+	IntervalDays int
+	Repetitions  int
+	Due          time.Time
+}
+
+// IsDue 復習の期日を迎えているかを判定する
+func (c Card) IsDue(now time.Time) bool {
+	return !c.Due.After(now)
+}
+
+// Review 回答の評価から次のスケジュールを計算する
+func Review(card Card, quality Quality, now time.Time) Card {
+	if quality < QualityBlackout {
+		quality = QualityBlackout
+	}
+	if quality > QualityPerfect {
+		quality = QualityPerfect
+	}
+
+	easeFactor := card.EaseFactor
+	if easeFactor == 0 {
+		easeFactor = DefaultEaseFactor
+	}
+
+	next := Card{}
+	if quality >= passingQuality {
+		switch card.Repetitions {
+		case 0:
+			next.IntervalDays = 1
+		case 1:
+			next.IntervalDays = 6
+		default:
+			next.IntervalDays = int(math.Round(float64(card.IntervalDays) * easeFactor))
+		}
+		next.Repetitions = card.Repetitions + 1
+	} else {
+		// 不正解の場合は最初から覚え直す
+		next.IntervalDays = 1
+		next.Repetitions = 0
+	}
+	if next.IntervalDays > MaxIntervalDays {
+		next.IntervalDays = MaxIntervalDays
+	}
+
+	q := float64(QualityPerfect - quality)
+	next.EaseFactor = math.Max(MinEaseFactor, easeFactor+0.1-q*(0.08+q*0.02))
+	next.Due = now.AddDate(0, 0, next.IntervalDays)
+
+	return next
+}
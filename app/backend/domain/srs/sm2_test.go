@@ -0,0 +1,134 @@
+package srs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReview(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		card            Card
+		quality         Quality
+		wantInterval    int
+		wantRepetitions int
+		wantEaseFactor  float64
+	}{
+		{
+			name:            "正常系_初回の正解",
+			card:            Card{},
+			quality:         QualityCorrect,
+			wantInterval:    1,
+			wantRepetitions: 1,
+			wantEaseFactor:  2.5,
+		},
+		{
+			name:            "正常系_2回目の正解",
+			card:            Card{EaseFactor: 2.5, IntervalDays: 1, Repetitions: 1},
+			quality:         QualityCorrect,
+			wantInterval:    6,
+			wantRepetitions: 2,
+			wantEaseFactor:  2.5,
+		},
+		{
+			name:            "正常系_3回目以降は易しさに応じて間隔が伸びる",
+			card:            Card{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2},
+			quality:         QualityCorrect,
+			wantInterval:    15,
+			wantRepetitions: 3,
+			wantEaseFactor:  2.5,
+		},
+		{
+			name:            "正常系_完璧な回答で易しさが上がる",
+			card:            Card{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2},
+			quality:         QualityPerfect,
+			wantInterval:    15,
+			wantRepetitions: 3,
+			wantEaseFactor:  2.6,
+		},
+		{
+			name:            "正常系_不正解で覚え直し",
+			card:            Card{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3},
+			quality:         QualityIncorrect,
+			wantInterval:    1,
+			wantRepetitions: 0,
+			wantEaseFactor:  2.18,
+		},
+		{
+			name:            "正常系_易しさは下限を下回らない",
+			card:            Card{EaseFactor: 1.3, IntervalDays: 1, Repetitions: 0},
+			quality:         QualityBlackout,
+			wantInterval:    1,
+			wantRepetitions: 0,
+			wantEaseFactor:  MinEaseFactor,
+		},
+		{
+			name:            "正常系_間隔は上限を超えない",
+			card:            Card{EaseFactor: 2.5, IntervalDays: 300, Repetitions: 8},
+			quality:         QualityCorrect,
+			wantInterval:    MaxIntervalDays,
+			wantRepetitions: 9,
+			wantEaseFactor:  2.5,
+		},
+		{
+			name:            "正常系_範囲外の評価は丸める",
+			card:            Card{},
+			quality:         Quality(9),
+			wantInterval:    1,
+			wantRepetitions: 1,
+			wantEaseFactor:  2.6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := Review(tt.card, tt.quality, now)
+
+			assert.Equal(t, tt.wantInterval, next.IntervalDays)
+			assert.Equal(t, tt.wantRepetitions, next.Repetitions)
+			assert.InDelta(t, tt.wantEaseFactor, next.EaseFactor, 1e-9)
+			assert.Equal(t, now.AddDate(0, 0, tt.wantInterval), next.Due)
+		})
+	}
+}
+
+func TestCard_IsDue(t *testing.T) {
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		card Card
+		want bool
+	}{
+		{
+			name: "正常系_未回答のカード",
+			card: Card{},
+			want: true,
+		},
+		{
+			name: "正常系_期日ちょうど",
+			card: Card{Due: now},
+			want: true,
+		},
+		{
+			name: "正常系_期日前",
+			card: Card{Due: now.Add(time.Minute)},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.card.IsDue(now))
+		})
+	}
+}
+
+func TestQualityFromAnswer(t *testing.T) {
+	assert.Equal(t, QualityCorrect, QualityFromAnswer(true))
+	assert.Equal(t, QualityIncorrect, QualityFromAnswer(false))
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/service"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const issuer = "audio-slide-app"
+
+type JWTTokenService struct {
+	secret          []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	now             func() time.Time
+}
+
+func NewJWTTokenService(secret []byte, accessTokenTTL, refreshTokenTTL time.Duration) service.ITokenService {
+	return &JWTTokenService{
+		secret:          secret,
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+		now:             time.Now,
+	}
+}
+
+// tokenClaims JWTのペイロード（sub にユーザーID、typ にトークン種別を持つ）
+type tokenClaims struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	Type  string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+func (s *JWTTokenService) IssueTokens(user *model.User) (*model.TokenPair, error) {
+	accessToken, err := s.sign(user, model.TokenTypeAccess, s.accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := s.sign(user, model.TokenTypeRefresh, s.refreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.accessTokenTTL / time.Second),
+	}, nil
+}
+
+func (s *JWTTokenService) ParseToken(token, tokenType string) (*model.TokenClaims, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil {
+		return nil, errs.NewUnauthorizedError("invalid or expired token")
+	}
+	if claims.Type != tokenType || claims.Subject == "" {
+		return nil, errs.NewUnauthorizedError("invalid token type")
+	}
+
+	return &model.TokenClaims{
+		UserID: claims.Subject,
+		Email:  claims.Email,
+		Role:   model.Role(claims.Role),
+		Type:   claims.Type,
+	}, nil
+}
+
+func (s *JWTTokenService) sign(user *model.User, tokenType string, ttl time.Duration) (string, error) {
+	now := s.now()
+	claims := tokenClaims{
+		Email: user.Email,
+		Role:  string(user.EffectiveRole()),
+		Type:  tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   user.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return "", errs.NewInternalServerError(fmt.Errorf("failed to sign %s token: %w", tokenType, err))
+	}
+	return token, nil
+}
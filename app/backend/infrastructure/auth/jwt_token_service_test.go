@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWTTokenService(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := &JWTTokenService{
+		secret:          []byte("secret"),
+		accessTokenTTL:  15 * time.Minute,
+		refreshTokenTTL: 24 * time.Hour,
+		now:             func() time.Time { return now },
+	}
+	user := model.NewUser("user_001", "taro@example.com", "", "", now)
+
+	tokens, err := service.IssueTokens(user)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(900), tokens.ExpiresIn)
+
+	otherService := &JWTTokenService{secret: []byte("other"), now: service.now}
+
+	tests := []struct {
+		name      string
+		service   *JWTTokenService
+		token     string
+		tokenType string
+		after     time.Duration
+		wantErr   bool
+	}{
+		{
+			name:      "正常系_アクセストークン",
+			service:   service,
+			token:     tokens.AccessToken,
+			tokenType: model.TokenTypeAccess,
+			wantErr:   false,
+		},
+		{
+			name:      "正常系_リフレッシュトークン",
+			service:   service,
+			token:     tokens.RefreshToken,
+			tokenType: model.TokenTypeRefresh,
+			after:     time.Hour,
+			wantErr:   false,
+		},
+		{
+			name:      "異常系_種別の取り違え",
+			service:   service,
+			token:     tokens.AccessToken,
+			tokenType: model.TokenTypeRefresh,
+			wantErr:   true,
+		},
+		{
+			name:      "異常系_有効期限切れ",
+			service:   service,
+			token:     tokens.AccessToken,
+			tokenType: model.TokenTypeAccess,
+			after:     16 * time.Minute,
+			wantErr:   true,
+		},
+		{
+			name:      "異常系_別の鍵で署名されたトークン",
+			service:   otherService,
+			token:     tokens.AccessToken,
+			tokenType: model.TokenTypeAccess,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.service.now = func() time.Time { return now.Add(tt.after) }
+			defer func() { tt.service.now = func() time.Time { return now } }()
+
+			claims, err := tt.service.ParseToken(tt.token, tt.tokenType)
+
+			if tt.wantErr {
+				if appErr, ok := err.(*errs.AppError); assert.True(t, ok) {
+					assert.Equal(t, errs.EC004, appErr.Code)
+				}
+				assert.Nil(t, claims)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "user_001", claims.UserID)
+				assert.Equal(t, "taro@example.com", claims.Email)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/logging"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// batchWriteMaxItems BatchWriteItem 1回あたりの最大件数
+	batchWriteMaxItems = 25
+	// batchGetMaxKeys BatchGetItem 1回あたりの最大件数
+	batchGetMaxKeys = 100
+	// batchWriteMaxRetries 未処理アイテムの再送回数の上限（BatchGetItem の未処理キーも同じ上限とする）
+	batchWriteMaxRetries = 5
+)
+
+// batchWrite 書き込みリクエストを25件ずつ BatchWriteItem で送信する
+// スロットリング等で未処理となったアイテムは待機して再送する
+func batchWrite(ctx context.Context, client *dynamodb.DynamoDB, requests []*dynamodb.WriteRequest) error {
+	for start := 0; start < len(requests); start += batchWriteMaxItems {
+		end := start + batchWriteMaxItems
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		pending := map[string][]*dynamodb.WriteRequest{
+			QuizTableName: requests[start:end],
+		}
+
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt > batchWriteMaxRetries {
+				return fmt.Errorf("batch write did not complete after %d retries", batchWriteMaxRetries)
+			}
+			if attempt > 0 {
+				logging.FromContext(ctx).Warn("retrying unprocessed batch write items", "attempt", attempt, "items", len(pending[QuizTableName]))
+				if err := waitRetry(ctx, attempt); err != nil {
+					return err
+				}
+			}
+
+			output, err := client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = output.UnprocessedItems
+		}
+	}
+
+	return nil
+}
+
+// batchGet キーを100件ずつ BatchGetItem で取得する（存在しないキーのアイテムは含まれない）
+// スロットリング等で未処理となったキーは待機して再送する
+func batchGet(ctx context.Context, client *dynamodb.DynamoDB, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	items := make([]map[string]*dynamodb.AttributeValue, 0, len(keys))
+	for start := 0; start < len(keys); start += batchGetMaxKeys {
+		end := start + batchGetMaxKeys
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		pending := map[string]*dynamodb.KeysAndAttributes{
+			QuizTableName: {Keys: keys[start:end]},
+		}
+
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt > batchWriteMaxRetries {
+				return nil, fmt.Errorf("batch get did not complete after %d retries", batchWriteMaxRetries)
+			}
+			if attempt > 0 {
+				logging.FromContext(ctx).Warn("retrying unprocessed batch get keys", "attempt", attempt, "keys", len(pending[QuizTableName].Keys))
+				if err := waitRetry(ctx, attempt); err != nil {
+					return nil, err
+				}
+			}
+
+			output, err := client.BatchGetItemWithContext(ctx, &dynamodb.BatchGetItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, output.Responses[QuizTableName]...)
+			pending = output.UnprocessedKeys
+		}
+	}
+
+	return items, nil
+}
+
+// waitRetry 再送の前に試行回数に応じて待機する
+func waitRetry(ctx context.Context, attempt int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Duration(1<<attempt) * 50 * time.Millisecond):
+		return nil
+	}
+}
+
+// deleteRequest キーを指定した削除リクエストを生成する
+func deleteRequest(pk, sk string) *dynamodb.WriteRequest {
+	return &dynamodb.WriteRequest{
+		DeleteRequest: &dynamodb.DeleteRequest{
+			Key: quizKey(pk, sk),
+		},
+	}
+}
+
+// putRequest アイテムを指定した登録リクエストを生成する
+func putRequest(item map[string]*dynamodb.AttributeValue) *dynamodb.WriteRequest {
+	return &dynamodb.WriteRequest{
+		PutRequest: &dynamodb.PutRequest{
+			Item: item,
+		},
+	}
+}
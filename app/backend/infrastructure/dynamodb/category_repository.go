@@ -2,11 +2,21 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
+	"audio-slide-app/common/errs"
 	"audio-slide-app/domain/model"
 	"audio-slide-app/domain/repository"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+const (
+	// EntityTypeIndexName entityType を持つアイテムのみを対象としたスパースGSI
+	EntityTypeIndexName = "entityType-index"
 )
 
 type CategoryRepository struct {
@@ -19,13 +29,181 @@ func NewCategoryRepository(client *dynamodb.DynamoDB) repository.ICategoryReposi
 	}
 }
 
+// GetCategoriesToData 削除中（MarkDeleting）のカテゴリは含めない
 func (r *CategoryRepository) GetCategoriesToData(ctx context.Context) ([]*model.Category, error) {
-	// 固定のカテゴリを返す（実際の実装では、DynamoDBから取得）
-	categories := []*model.Category{
-		model.NewCategory("flags", "国旗", "世界各国の国旗を学習", "https://cdn.example.com/thumbnails/flags.jpg"),
-		model.NewCategory("animals", "動物", "様々な動物を学習", "https://cdn.example.com/thumbnails/animals.jpg"),
-		model.NewCategory("words", "言葉", "基本的な単語を学習", "https://cdn.example.com/thumbnails/words.jpg"),
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(QuizTableName),
+		IndexName:              aws.String(EntityTypeIndexName),
+		KeyConditionExpression: aws.String("entityType = :entityType"),
+		FilterExpression:       aws.String("attribute_not_exists(deleting)"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":entityType": {
+				S: aws.String(model.EntityTypeCategory),
+			},
+		},
 	}
 
+	categories := []*model.Category{}
+	var unmarshalErr error
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			var category model.Category
+			if err := dynamodbattribute.UnmarshalMap(item, &category); err != nil {
+				unmarshalErr = err
+				return false
+			}
+			categories = append(categories, &category)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query categories: %w", err))
+	}
+	if unmarshalErr != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal category: %w", unmarshalErr))
+	}
+
+	// 表示順、ID順で並べる
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].SortOrder != categories[j].SortOrder {
+			return categories[i].SortOrder < categories[j].SortOrder
+		}
+		return categories[i].ID < categories[j].ID
+	})
+
 	return categories, nil
 }
+
+// GetCategoryByIDToData 削除中のカテゴリは見つからないものとして扱う
+func (r *CategoryRepository) GetCategoryByIDToData(ctx context.Context, id string) (*model.Category, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(QuizTableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			"PK": {
+				S: aws.String(fmt.Sprintf("CATEGORY#%s", id)),
+			},
+			"SK": {
+				S: aws.String("META"),
+			},
+		},
+	}
+
+	result, err := r.client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get category: %w", err))
+	}
+
+	if result.Item == nil {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("category with id '%s' not found", id))
+	}
+	if _, deleting := result.Item["deleting"]; deleting {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("category with id '%s' not found", id))
+	}
+
+	var category model.Category
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &category); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal category: %w", err))
+	}
+
+	return &category, nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *model.Category) error {
+	if err := r.putCategory(ctx, category, "attribute_not_exists(PK)"); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewBadRequestError(fmt.Sprintf("category with id '%s' already exists", category.ID))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to create category: %w", err))
+	}
+	return nil
+}
+
+// Update 削除中のカテゴリは更新しない（上書きで削除中の印が消えないようにする）
+func (r *CategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	if err := r.putCategory(ctx, category, "attribute_exists(PK) AND attribute_not_exists(deleting)"); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewNotFoundError(fmt.Sprintf("category with id '%s' not found or is being deleted", category.ID))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to update category: %w", err))
+	}
+	return nil
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
+	input := &dynamodb.DeleteItemInput{
+		TableName:           aws.String(QuizTableName),
+		Key:                 quizKey(fmt.Sprintf("CATEGORY#%s", id), "META"),
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	}
+
+	if _, err := r.client.DeleteItemWithContext(ctx, input); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewNotFoundError(fmt.Sprintf("category with id '%s' not found", id))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to delete category: %w", err))
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) MarkDeleting(ctx context.Context, id string) error {
+	return r.updateDeleting(ctx, id, "SET deleting = :true", map[string]*dynamodb.AttributeValue{
+		":true": {BOOL: aws.Bool(true)},
+	})
+}
+
+func (r *CategoryRepository) UnmarkDeleting(ctx context.Context, id string) error {
+	return r.updateDeleting(ctx, id, "REMOVE deleting", nil)
+}
+
+func (r *CategoryRepository) updateDeleting(ctx context.Context, id, expression string, values map[string]*dynamodb.AttributeValue) error {
+	input := &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(QuizTableName),
+		Key:                       quizKey(fmt.Sprintf("CATEGORY#%s", id), "META"),
+		UpdateExpression:          aws.String(expression),
+		ConditionExpression:       aws.String("attribute_exists(PK)"),
+		ExpressionAttributeValues: values,
+	}
+
+	if _, err := r.client.UpdateItemWithContext(ctx, input); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewNotFoundError(fmt.Sprintf("category with id '%s' not found", id))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to update category '%s': %w", id, err))
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) BatchPut(ctx context.Context, categories []*model.Category) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(categories))
+	for _, category := range categories {
+		item, err := dynamodbattribute.MarshalMap(category)
+		if err != nil {
+			return errs.NewInternalServerError(fmt.Errorf("failed to marshal category: %w", err))
+		}
+		requests = append(requests, putRequest(item))
+	}
+
+	if err := batchWrite(ctx, r.client, requests); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to put categories: %w", err))
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) putCategory(ctx context.Context, category *model.Category, condition string) error {
+	item, err := dynamodbattribute.MarshalMap(category)
+	if err != nil {
+		return fmt.Errorf("failed to marshal category: %w", err)
+	}
+
+	input := &dynamodb.PutItemInput{
+		TableName:           aws.String(QuizTableName),
+		Item:                item,
+		ConditionExpression: aws.String(condition),
+	}
+
+	_, err = r.client.PutItemWithContext(ctx, input)
+	return err
+}
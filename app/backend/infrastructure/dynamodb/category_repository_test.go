@@ -0,0 +1,77 @@
+package dynamodb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"audio-slide-app/common/errs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryRepository_GetCategoriesToData(t *testing.T) {
+	client, requests := newRecordingFakeClient(t, map[string]func(w http.ResponseWriter){
+		"Query": respondJSON(http.StatusOK, `{"Count":2,"Items":[`+
+			`{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"META"},"id":{"S":"flags"},"name":{"S":"国旗"},"sortOrder":{"N":"2"}},`+
+			`{"PK":{"S":"CATEGORY#animals"},"SK":{"S":"META"},"id":{"S":"animals"},"name":{"S":"動物"},"sortOrder":{"N":"1"}}]}`),
+	})
+	repo := NewCategoryRepository(client)
+
+	categories, err := repo.GetCategoriesToData(context.Background())
+
+	assert.NoError(t, err)
+	if assert.Len(t, categories, 2) {
+		assert.Equal(t, "animals", categories[0].ID)
+		assert.Equal(t, "flags", categories[1].ID)
+	}
+	// 削除中のカテゴリは読み込む時点で除く
+	assert.Contains(t, requests["Query"], `"FilterExpression":"attribute_not_exists(deleting)"`)
+}
+
+func TestCategoryRepository_GetCategoryByIDToData(t *testing.T) {
+	tests := []struct {
+		name    string
+		getItem func(w http.ResponseWriter)
+		wantErr bool
+		errType string
+	}{
+		{
+			name:    "正常系",
+			getItem: respondJSON(http.StatusOK, `{"Item":{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"META"},"id":{"S":"flags"},"name":{"S":"国旗"}}}`),
+			wantErr: false,
+		},
+		{
+			name:    "異常系_カテゴリが見つからない",
+			getItem: respondJSON(http.StatusOK, `{}`),
+			wantErr: true,
+			errType: errs.EC002,
+		},
+		{
+			name:    "異常系_削除中のカテゴリ",
+			getItem: respondJSON(http.StatusOK, `{"Item":{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"META"},"id":{"S":"flags"},"name":{"S":"国旗"},"deleting":{"BOOL":true}}}`),
+			wantErr: true,
+			errType: errs.EC002,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewCategoryRepository(newFakeClient(t, map[string]func(w http.ResponseWriter){
+				"GetItem": tt.getItem,
+			}))
+
+			category, err := repo.GetCategoryByIDToData(context.Background(), "flags")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "flags", category.ID)
+		})
+	}
+}
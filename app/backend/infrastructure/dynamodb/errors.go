@@ -0,0 +1,42 @@
+package dynamodb
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// isConditionalCheckFailed 条件付き書き込みの条件不一致エラーかを判定する
+func isConditionalCheckFailed(err error) bool {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		return aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+	}
+	return false
+}
+
+// isTransactionConditionFailed トランザクション内の条件付き書き込みが条件不一致で取り消されたかを判定する
+func isTransactionConditionFailed(err error) bool {
+	var canceled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return false
+	}
+	for _, reason := range canceled.CancellationReasons {
+		if reason != nil && aws.StringValue(reason.Code) == "ConditionalCheckFailed" {
+			return true
+		}
+	}
+	return false
+}
+
+// isTransactionConditionFailedAt トランザクションの index 番目（0始まり）の項目が条件不一致だったかを判定する
+func isTransactionConditionFailedAt(err error, index int) bool {
+	var canceled *dynamodb.TransactionCanceledException
+	if !errors.As(err, &canceled) || index >= len(canceled.CancellationReasons) {
+		return false
+	}
+	reason := canceled.CancellationReasons[index]
+	return reason != nil && aws.StringValue(reason.Code) == "ConditionalCheckFailed"
+}
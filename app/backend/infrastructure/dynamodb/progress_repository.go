@@ -0,0 +1,170 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// 学習履歴はユーザーと同じパーティション（USER#<userId>）に保存する
+// 回答履歴は ANSWER#<回答日時>#<quizId>、問題ごとの習熟状況は PROGRESS#<category>#<quizId>
+type ProgressRepository struct {
+	client *dynamodb.DynamoDB
+}
+
+func NewProgressRepository(client *dynamodb.DynamoDB) repository.IProgressRepository {
+	return &ProgressRepository{
+		client: client,
+	}
+}
+
+func (r *ProgressRepository) GetQuizProgressToData(ctx context.Context, userID, category, quizID string) (*model.QuizProgress, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(QuizTableName),
+		Key:       quizKey(fmt.Sprintf("USER#%s", userID), model.QuizProgressSK(category, quizID)),
+	}
+
+	result, err := r.client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get quiz progress: %w", err))
+	}
+
+	if result.Item == nil {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("progress for quiz '%s' not found", quizID))
+	}
+
+	var progress model.QuizProgress
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &progress); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz progress: %w", err))
+	}
+
+	return &progress, nil
+}
+
+func (r *ProgressRepository) GetQuizProgressesToData(ctx context.Context, userID, category string) ([]*model.QuizProgress, error) {
+	prefix := "PROGRESS#"
+	if category != "" {
+		prefix = fmt.Sprintf("PROGRESS#%s#", category)
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(QuizTableName),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :prefix)"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk": {
+				S: aws.String(fmt.Sprintf("USER#%s", userID)),
+			},
+			":prefix": {
+				S: aws.String(prefix),
+			},
+		},
+	}
+
+	progresses := []*model.QuizProgress{}
+	var unmarshalErr error
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			var progress model.QuizProgress
+			if err := dynamodbattribute.UnmarshalMap(item, &progress); err != nil {
+				unmarshalErr = err
+				return false
+			}
+			progresses = append(progresses, &progress)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query quiz progresses: %w", err))
+	}
+	if unmarshalErr != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz progress: %w", unmarshalErr))
+	}
+
+	return progresses, nil
+}
+
+func (r *ProgressRepository) SaveAnswer(ctx context.Context, record *model.AnswerRecord, progress *model.QuizProgress) error {
+	recordItem, err := dynamodbattribute.MarshalMap(record)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal answer record: %w", err))
+	}
+	progressItem, err := dynamodbattribute.MarshalMap(progress)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal quiz progress: %w", err))
+	}
+
+	input := &dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{
+				Put: &dynamodb.Put{
+					TableName: aws.String(QuizTableName),
+					Item:      recordItem,
+				},
+			},
+			{
+				Update: progressUpdate(record, progressItem),
+			},
+		},
+	}
+
+	if _, err := r.client.TransactWriteItemsWithContext(ctx, input); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to save answer: %w", err))
+	}
+
+	return nil
+}
+
+// progressUpdate 習熟状況の更新内容を生成する
+// 同じ問題への同時回答で回数が失われないよう、回答数・正解数・連続正解数は読み取った値ではなく ADD で加算する
+// 復習スケジュールと最終回答は読み取った状態から計算した値を書き込むため後勝ちとなる
+func progressUpdate(record *model.AnswerRecord, progressItem map[string]*dynamodb.AttributeValue) *dynamodb.Update {
+	correct := "0"
+	if record.IsCorrect {
+		correct = "1"
+	}
+
+	names := map[string]*string{}
+	values := map[string]*dynamodb.AttributeValue{
+		":one": {
+			N: aws.String("1"),
+		},
+		":correct": {
+			N: aws.String(correct),
+		},
+	}
+	sets := []string{}
+	for _, name := range []string{"userId", "progressQuizId", "progressCategory", "lastCorrect", "lastAnsweredAt", "easeFactor", "intervalDays", "repetitions", "dueAt"} {
+		sets = append(sets, fmt.Sprintf("#%s = :%s", name, name))
+		names["#"+name] = aws.String(name)
+		values[":"+name] = progressItem[name]
+	}
+	adds := []string{"#attempts :one", "#correctCount :correct"}
+	names["#attempts"] = aws.String("attempts")
+	names["#correctCount"] = aws.String("correctCount")
+	names["#streak"] = aws.String("streak")
+	if record.IsCorrect {
+		adds = append(adds, "#streak :one")
+	} else {
+		sets = append(sets, "#streak = :zero")
+		values[":zero"] = &dynamodb.AttributeValue{N: aws.String("0")}
+	}
+
+	return &dynamodb.Update{
+		TableName: aws.String(QuizTableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			"PK": progressItem["PK"],
+			"SK": progressItem["SK"],
+		},
+		UpdateExpression:          aws.String(fmt.Sprintf("SET %s ADD %s", strings.Join(sets, ", "), strings.Join(adds, ", "))),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	}
+}
@@ -0,0 +1,64 @@
+package dynamodb
+
+import (
+	"testing"
+	"time"
+
+	"audio-slide-app/domain/model"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressUpdate(t *testing.T) {
+	answeredAt := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	quiz := model.NewQuiz("quiz_001", "images/flags/it.png", "", "イタリア", []string{"イタリア", "フランス"}, "flags", "")
+
+	tests := []struct {
+		name           string
+		answer         string
+		wantExpression string
+		wantCorrect    string
+	}{
+		{
+			name:           "正常系_正解は連続正解数も加算",
+			answer:         "イタリア",
+			wantExpression: "SET #userId = :userId, #progressQuizId = :progressQuizId, #progressCategory = :progressCategory, #lastCorrect = :lastCorrect, #lastAnsweredAt = :lastAnsweredAt, #easeFactor = :easeFactor, #intervalDays = :intervalDays, #repetitions = :repetitions, #dueAt = :dueAt ADD #attempts :one, #correctCount :correct, #streak :one",
+			wantCorrect:    "1",
+		},
+		{
+			name:           "正常系_不正解は連続正解数をリセット",
+			answer:         "フランス",
+			wantExpression: "SET #userId = :userId, #progressQuizId = :progressQuizId, #progressCategory = :progressCategory, #lastCorrect = :lastCorrect, #lastAnsweredAt = :lastAnsweredAt, #easeFactor = :easeFactor, #intervalDays = :intervalDays, #repetitions = :repetitions, #dueAt = :dueAt, #streak = :zero ADD #attempts :one, #correctCount :correct",
+			wantCorrect:    "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := model.NewAnswerResult(quiz, tt.answer)
+			record := model.NewAnswerRecord("user_001", result, answeredAt)
+			progress := model.NewQuizProgress("user_001", "flags", "quiz_001")
+			progress.Record(result.IsCorrect, answeredAt)
+			progressItem, err := dynamodbattribute.MarshalMap(progress)
+			require.NoError(t, err)
+
+			update := progressUpdate(record, progressItem)
+
+			assert.Equal(t, tt.wantExpression, aws.StringValue(update.UpdateExpression))
+			assert.Equal(t, "USER#user_001", aws.StringValue(update.Key["PK"].S))
+			assert.Equal(t, "PROGRESS#flags#quiz_001", aws.StringValue(update.Key["SK"].S))
+			assert.Equal(t, tt.wantCorrect, aws.StringValue(update.ExpressionAttributeValues[":correct"].N))
+			assert.Equal(t, progressItem["dueAt"], update.ExpressionAttributeValues[":dueAt"])
+			// 式で使うすべての名前と値が定義されている
+			for name := range update.ExpressionAttributeNames {
+				assert.Contains(t, tt.wantExpression, name)
+			}
+			for value := range update.ExpressionAttributeValues {
+				assert.Contains(t, tt.wantExpression, value)
+			}
+		})
+	}
+}
@@ -16,6 +16,12 @@ import (
 
 const (
 	QuizTableName = "Quiz"
+	// CategoryIDIndexName クイズのみが載るカテゴリ別のGSI（category + id）
+	CategoryIDIndexName = "category-id-index"
+	// IDIndexName クイズIDでアイテムを特定するためのGSI
+	IDIndexName = "id-index"
+	// RandomIndexName カテゴリ内のクイズをランダムな順序で取得するためのGSI（category + randomKey）
+	RandomIndexName = "category-random-index"
 )
 
 type QuizRepository struct {
@@ -28,75 +34,550 @@ func NewQuizRepository(client *dynamodb.DynamoDB) repository.IQuizRepository {
 	}
 }
 
+// GetQuizzesByCategoryToData カテゴリ内からランダムに count 件のクイズを取得する
+// randomKey の値域からランダムな開始位置を選び、そこから count 件だけを読み取る（足りなければ先頭に折り返す）
+// count が 0 以下の場合はカテゴリ内の全件をシャッフルして返す
 func (r *QuizRepository) GetQuizzesByCategoryToData(ctx context.Context, category string, count int) ([]*model.Quiz, error) {
+	if count <= 0 {
+		quizzes, err := r.getAllQuizzesByCategory(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+		r.shuffleQuizzes(quizzes)
+		return quizzes, nil
+	}
+
+	start := newRandomKey()
+	quizzes, err := r.queryRandomRange(ctx, category, "randomKey >= :start", start, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(quizzes) < count {
+		wrapped, err := r.queryRandomRange(ctx, category, "randomKey < :start", start, count-len(quizzes))
+		if err != nil {
+			return nil, err
+		}
+		quizzes = append(quizzes, wrapped...)
+	}
+
+	// 同じ開始位置付近では並びが固定されるため、取得した範囲内でもシャッフルする
+	r.shuffleQuizzes(quizzes)
+
+	return quizzes, nil
+}
+
+func (r *QuizRepository) GetQuizPageByCategoryToData(ctx context.Context, category string, limit int, startKey map[string]string) ([]*model.Quiz, map[string]string, error) {
+	input := r.categoryQuizzesQuery(category)
+	input.Limit = aws.Int64(int64(limit))
+	if len(startKey) > 0 {
+		input.ExclusiveStartKey = quizKey(startKey["PK"], startKey["SK"])
+	}
+
+	result, err := r.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(fmt.Errorf("failed to query quizzes: %w", err))
+	}
+
+	quizzes, err := unmarshalQuizzes(result.Items)
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+	}
+
+	// 続きがない場合 LastEvaluatedKey は返らない
+	var nextKey map[string]string
+	if len(result.LastEvaluatedKey) > 0 {
+		nextKey = map[string]string{
+			"PK": aws.StringValue(result.LastEvaluatedKey["PK"].S),
+			"SK": aws.StringValue(result.LastEvaluatedKey["SK"].S),
+		}
+	}
+
+	return quizzes, nextKey, nil
+}
+
+func (r *QuizRepository) GetQuizByIDToData(ctx context.Context, id string) (*model.Quiz, error) {
 	input := &dynamodb.QueryInput{
 		TableName:              aws.String(QuizTableName),
-		KeyConditionExpression: aws.String("PK = :pk"),
+		IndexName:              aws.String(IDIndexName),
+		KeyConditionExpression: aws.String("id = :id"),
+		// 同じIDを持つカテゴリ情報（SK=META）などを除外する
+		FilterExpression: aws.String("begins_with(SK, :skPrefix)"),
 		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
-			":pk": {
-				S: aws.String(fmt.Sprintf("CATEGORY#%s", category)),
+			":id": {
+				S: aws.String(id),
+			},
+			":skPrefix": {
+				S: aws.String("QUIZ#"),
 			},
 		},
 	}
 
 	result, err := r.client.QueryWithContext(ctx, input)
 	if err != nil {
-		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query quizzes: %w", err))
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query quiz by id: %w", err))
 	}
 
-	var quizzes []*model.Quiz
-	for _, item := range result.Items {
-		var quiz model.Quiz
-		if err := dynamodbattribute.UnmarshalMap(item, &quiz); err != nil {
-			return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+	if len(result.Items) == 0 {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("quiz with id '%s' not found", id))
+	}
+
+	var quiz model.Quiz
+	if err := dynamodbattribute.UnmarshalMap(result.Items[0], &quiz); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+	}
+
+	return &quiz, nil
+}
+
+// GetQuizzesByIDsToData クイズのキー（CATEGORY#<category> / QUIZ#<id>）を指定して BatchGetItem で取得する
+func (r *QuizRepository) GetQuizzesByIDsToData(ctx context.Context, category string, ids []string) ([]*model.Quiz, error) {
+	// BatchGetItem は同じキーを重複して指定できない
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(ids))
+	requested := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if requested[id] {
+			continue
 		}
-		quizzes = append(quizzes, &quiz)
+		requested[id] = true
+		keys = append(keys, quizKey(fmt.Sprintf("CATEGORY#%s", category), fmt.Sprintf("QUIZ#%s", id)))
 	}
 
-	// ランダムにシャッフル
-	r.shuffleQuizzes(quizzes)
+	items, err := batchGet(ctx, r.client, keys)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get quizzes by id: %w", err))
+	}
 
-	// 指定された数まで制限
-	if count > 0 && count < len(quizzes) {
-		quizzes = quizzes[:count]
+	found, err := unmarshalQuizzes(items)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+	}
+
+	// BatchGetItem の結果は順不同のため、指定された順に並べ直す
+	byID := make(map[string]*model.Quiz, len(found))
+	for _, quiz := range found {
+		byID[quiz.ID] = quiz
+	}
+	quizzes := make([]*model.Quiz, 0, len(found))
+	for _, id := range ids {
+		if quiz, ok := byID[id]; ok {
+			quizzes = append(quizzes, quiz)
+			delete(byID, id)
+		}
 	}
 
 	return quizzes, nil
 }
 
-func (r *QuizRepository) GetQuizByIDToData(ctx context.Context, id string) (*model.Quiz, error) {
-	// IDからカテゴリを特定する必要がある（実際の実装では、GSIまたは別の方法を使用）
-	// ここでは簡単な実装として、全カテゴリを検索
-	categories := []string{"flags", "animals", "words"}
-
-	for _, category := range categories {
-		input := &dynamodb.GetItemInput{
-			TableName: aws.String(QuizTableName),
-			Key: map[string]*dynamodb.AttributeValue{
-				"PK": {
-					S: aws.String(fmt.Sprintf("CATEGORY#%s", category)),
+func (r *QuizRepository) Create(ctx context.Context, quiz *model.Quiz) error {
+	ensureRandomKey(quiz)
+
+	item, err := dynamodbattribute.MarshalMap(quiz)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal quiz: %w", err))
+	}
+
+	// 同時に削除されたカテゴリに登録しないよう、カテゴリの存在も同じトランザクションで確認する
+	input := &dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{
+				Put: &dynamodb.Put{
+					TableName:           aws.String(QuizTableName),
+					Item:                item,
+					ConditionExpression: aws.String("attribute_not_exists(PK)"),
+				},
+			},
+			categoryAvailableCheck(quiz.Category),
+		},
+	}
+
+	if _, err := r.client.TransactWriteItemsWithContext(ctx, input); err != nil {
+		switch {
+		case isTransactionConditionFailedAt(err, 0):
+			return errs.NewBadRequestError(fmt.Sprintf("quiz with id '%s' already exists", quiz.ID))
+		case isTransactionConditionFailedAt(err, 1):
+			return errs.NewBadRequestError(fmt.Sprintf("category '%s' does not exist", quiz.Category))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to create quiz: %w", err))
+	}
+
+	return nil
+}
+
+func (r *QuizRepository) Update(ctx context.Context, quiz *model.Quiz) error {
+	current, err := r.GetQuizByIDToData(ctx, quiz.ID)
+	if err != nil {
+		return err
+	}
+
+	// 更新時もランダム出題上の位置は維持する
+	if quiz.RandomKey == "" {
+		quiz.RandomKey = current.RandomKey
+	}
+	ensureRandomKey(quiz)
+
+	item, err := dynamodbattribute.MarshalMap(quiz)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal quiz: %w", err))
+	}
+
+	// カテゴリが変わらない場合は同じキーで上書き
+	if current.PK == quiz.PK && current.SK == quiz.SK {
+		input := &dynamodb.PutItemInput{
+			TableName:           aws.String(QuizTableName),
+			Item:                item,
+			ConditionExpression: aws.String("attribute_exists(PK)"),
+		}
+
+		if _, err := r.client.PutItemWithContext(ctx, input); err != nil {
+			if isConditionalCheckFailed(err) {
+				return errs.NewNotFoundError(fmt.Sprintf("quiz with id '%s' not found", quiz.ID))
+			}
+			return errs.NewInternalServerError(fmt.Errorf("failed to update quiz: %w", err))
+		}
+		return nil
+	}
+
+	// カテゴリが変わる場合は旧パーティションの削除と新パーティションへの登録を同一トランザクションで行う
+	// 同時に削除されたカテゴリへ移動しないよう、移動先のカテゴリの存在も同じトランザクションで確認する
+	input := &dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{
+				Delete: &dynamodb.Delete{
+					TableName:           aws.String(QuizTableName),
+					Key:                 quizKey(current.PK, current.SK),
+					ConditionExpression: aws.String("attribute_exists(PK)"),
 				},
-				"SK": {
-					S: aws.String(fmt.Sprintf("QUIZ#%s", id)),
+			},
+			{
+				Put: &dynamodb.Put{
+					TableName:           aws.String(QuizTableName),
+					Item:                item,
+					ConditionExpression: aws.String("attribute_not_exists(PK)"),
 				},
 			},
+			categoryAvailableCheck(quiz.Category),
+		},
+	}
+
+	if _, err := r.client.TransactWriteItemsWithContext(ctx, input); err != nil {
+		switch {
+		case isTransactionConditionFailedAt(err, 0):
+			return errs.NewNotFoundError(fmt.Sprintf("quiz with id '%s' not found", quiz.ID))
+		case isTransactionConditionFailedAt(err, 1):
+			return errs.NewBadRequestError(fmt.Sprintf("quiz '%s' already exists in category '%s'", quiz.ID, quiz.Category))
+		case isTransactionConditionFailedAt(err, 2):
+			return errs.NewBadRequestError(fmt.Sprintf("category '%s' does not exist", quiz.Category))
 		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to move quiz to category '%s': %w", quiz.Category, err))
+	}
 
-		result, err := r.client.GetItemWithContext(ctx, input)
-		if err != nil {
-			continue
+	return nil
+}
+
+func (r *QuizRepository) Delete(ctx context.Context, id string) error {
+	current, err := r.GetQuizByIDToData(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.DeleteItemInput{
+		TableName:           aws.String(QuizTableName),
+		Key:                 quizKey(current.PK, current.SK),
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	}
+
+	if _, err := r.client.DeleteItemWithContext(ctx, input); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewNotFoundError(fmt.Sprintf("quiz with id '%s' not found", id))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to delete quiz: %w", err))
+	}
+
+	return nil
+}
+
+func (r *QuizRepository) CountQuizzesByCategoryToData(ctx context.Context, category string) (int, error) {
+	input := r.categoryQuizzesQuery(category)
+	input.Select = aws.String(dynamodb.SelectCount)
+	// カテゴリの削除前の確認に使うため、直前に登録されたクイズも数える
+	input.ConsistentRead = aws.Bool(true)
+
+	count := 0
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		count += int(aws.Int64Value(page.Count))
+		return true
+	})
+	if err != nil {
+		return 0, errs.NewInternalServerError(fmt.Errorf("failed to count quizzes: %w", err))
+	}
+
+	return count, nil
+}
+
+// CountQuizzesPerCategoryToData カテゴリごとのクイズ数を返す
+// カテゴリごとに Count クエリを発行せず、category-id-index を1回の Scan（ページ単位）でカテゴリ属性のみ読み取って集計する
+func (r *QuizRepository) CountQuizzesPerCategoryToData(ctx context.Context) (map[string]int, error) {
+	input := &dynamodb.ScanInput{
+		TableName:            aws.String(QuizTableName),
+		IndexName:            aws.String(CategoryIDIndexName),
+		ProjectionExpression: aws.String("category"),
+	}
+
+	counts := make(map[string]int)
+	err := r.client.ScanPagesWithContext(ctx, input, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			if category := item["category"]; category != nil {
+				counts[aws.StringValue(category.S)]++
+			}
 		}
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to count quizzes per category: %w", err))
+	}
 
-		if result.Item != nil {
+	return counts, nil
+}
+
+// GetCorrectAnswersByCategoryToData 誤答の生成に使うため、カテゴリ内のクイズの正解のみを重複なしで取得する
+// 日本語以外は翻訳のあるクイズの正解のみを対象とする
+func (r *QuizRepository) GetCorrectAnswersByCategoryToData(ctx context.Context, category, lang string) ([]string, error) {
+	input := r.categoryQuizzesQuery(category)
+	input.ProjectionExpression = aws.String("correctAnswer, translations")
+
+	answers := []string{}
+	seen := make(map[string]bool)
+	var unmarshalErr error
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range page.Items {
 			var quiz model.Quiz
-			if err := dynamodbattribute.UnmarshalMap(result.Item, &quiz); err != nil {
-				return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+			if err := dynamodbattribute.UnmarshalMap(item, &quiz); err != nil {
+				unmarshalErr = err
+				return false
+			}
+			answer := quiz.AnswerIn(lang)
+			if answer != "" && !seen[answer] {
+				seen[answer] = true
+				answers = append(answers, answer)
 			}
-			return &quiz, nil
 		}
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query correct answers: %w", err))
+	}
+	if unmarshalErr != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal correct answers: %w", unmarshalErr))
+	}
+
+	return answers, nil
+}
+
+func (r *QuizRepository) DeleteByCategory(ctx context.Context, category string) error {
+	input := r.categoryQuizzesQuery(category)
+	input.ProjectionExpression = aws.String("PK, SK")
+	input.ConsistentRead = aws.Bool(true)
+
+	var requests []*dynamodb.WriteRequest
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			requests = append(requests, deleteRequest(aws.StringValue(item["PK"].S), aws.StringValue(item["SK"].S)))
+		}
+		return true
+	})
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to query quizzes: %w", err))
+	}
+
+	if err := batchWrite(ctx, r.client, requests); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to delete quizzes: %w", err))
+	}
+
+	return nil
+}
+
+func (r *QuizRepository) GetAllQuizzesToData(ctx context.Context) ([]*model.Quiz, error) {
+	// 全件取得はコンテンツ管理ツール向けのためScanを使用する
+	input := &dynamodb.ScanInput{
+		TableName:        aws.String(QuizTableName),
+		FilterExpression: aws.String("begins_with(SK, :skPrefix)"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":skPrefix": {
+				S: aws.String("QUIZ#"),
+			},
+		},
 	}
 
-	return nil, errs.NewNotFoundError(fmt.Sprintf("quiz with id '%s' not found", id))
+	quizzes := []*model.Quiz{}
+	var unmarshalErr error
+	err := r.client.ScanPagesWithContext(ctx, input, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			var quiz model.Quiz
+			if err := dynamodbattribute.UnmarshalMap(item, &quiz); err != nil {
+				unmarshalErr = err
+				return false
+			}
+			quizzes = append(quizzes, &quiz)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to scan quizzes: %w", err))
+	}
+	if unmarshalErr != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", unmarshalErr))
+	}
+
+	return quizzes, nil
+}
+
+func (r *QuizRepository) BatchPut(ctx context.Context, quizzes []*model.Quiz) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		ensureRandomKey(quiz)
+		item, err := dynamodbattribute.MarshalMap(quiz)
+		if err != nil {
+			return errs.NewInternalServerError(fmt.Errorf("failed to marshal quiz: %w", err))
+		}
+		requests = append(requests, putRequest(item))
+	}
+
+	if err := batchWrite(ctx, r.client, requests); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to put quizzes: %w", err))
+	}
+
+	return nil
+}
+
+func (r *QuizRepository) BatchDelete(ctx context.Context, quizzes []*model.Quiz) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(quizzes))
+	for _, quiz := range quizzes {
+		requests = append(requests, deleteRequest(quiz.PK, quiz.SK))
+	}
+
+	if err := batchWrite(ctx, r.client, requests); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to delete quizzes: %w", err))
+	}
+
+	return nil
+}
+
+func (r *QuizRepository) getAllQuizzesByCategory(ctx context.Context, category string) ([]*model.Quiz, error) {
+	input := r.categoryQuizzesQuery(category)
+
+	// 1回のQueryは最大1MBまでのため、LastEvaluatedKeyをたどって全件を対象にする
+	var quizzes []*model.Quiz
+	var unmarshalErr error
+	err := r.client.QueryPagesWithContext(ctx, input, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		pageQuizzes, err := unmarshalQuizzes(page.Items)
+		if err != nil {
+			unmarshalErr = err
+			return false
+		}
+		quizzes = append(quizzes, pageQuizzes...)
+		return true
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query quizzes: %w", err))
+	}
+	if unmarshalErr != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", unmarshalErr))
+	}
+
+	return quizzes, nil
+}
+
+// queryRandomRange category-random-index から randomKey の条件に合うクイズを最大 limit 件取得する
+func (r *QuizRepository) queryRandomRange(ctx context.Context, category, randomKeyCondition, start string, limit int) ([]*model.Quiz, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(QuizTableName),
+		IndexName:              aws.String(RandomIndexName),
+		KeyConditionExpression: aws.String("category = :category AND " + randomKeyCondition),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":category": {
+				S: aws.String(category),
+			},
+			":start": {
+				S: aws.String(start),
+			},
+		},
+		Limit: aws.Int64(int64(limit)),
+	}
+
+	result, err := r.client.QueryWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to query random quizzes: %w", err))
+	}
+
+	quizzes, err := unmarshalQuizzes(result.Items)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal quiz: %w", err))
+	}
+
+	return quizzes, nil
+}
+
+// categoryQuizzesQuery カテゴリ内のクイズアイテムのみを対象とするクエリを生成する
+func (r *QuizRepository) categoryQuizzesQuery(category string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(QuizTableName),
+		KeyConditionExpression: aws.String("PK = :pk AND begins_with(SK, :skPrefix)"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":pk": {
+				S: aws.String(fmt.Sprintf("CATEGORY#%s", category)),
+			},
+			// 同じパーティションのカテゴリ情報（SK=META）を除外する
+			":skPrefix": {
+				S: aws.String("QUIZ#"),
+			},
+		},
+	}
+}
+
+func unmarshalQuizzes(items []map[string]*dynamodb.AttributeValue) ([]*model.Quiz, error) {
+	quizzes := make([]*model.Quiz, 0, len(items))
+	for _, item := range items {
+		var quiz model.Quiz
+		if err := dynamodbattribute.UnmarshalMap(item, &quiz); err != nil {
+			return nil, err
+		}
+		quizzes = append(quizzes, &quiz)
+	}
+	return quizzes, nil
+}
+
+// categoryAvailableCheck カテゴリが存在し、削除中（CategoryRepository.MarkDeleting）でないことを確認する
+func categoryAvailableCheck(category string) *dynamodb.TransactWriteItem {
+	return &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:           aws.String(QuizTableName),
+			Key:                 quizKey(fmt.Sprintf("CATEGORY#%s", category), "META"),
+			ConditionExpression: aws.String("attribute_exists(PK) AND attribute_not_exists(deleting)"),
+		},
+	}
+}
+
+func quizKey(pk, sk string) map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"PK": {
+			S: aws.String(pk),
+		},
+		"SK": {
+			S: aws.String(sk),
+		},
+	}
+}
+
+// newRandomKey randomKey 属性およびランダム取得の開始位置に使う16桁の16進数を生成する
+func newRandomKey() string {
+	return fmt.Sprintf("%016x", rand.Uint64())
+}
+
+// ensureRandomKey ランダム出題の対象となるよう、randomKey が未設定のクイズに割り当てる
+func ensureRandomKey(quiz *model.Quiz) {
+	if quiz.RandomKey == "" {
+		quiz.RandomKey = newRandomKey()
+	}
 }
 
 func (r *QuizRepository) shuffleQuizzes(quizzes []*model.Quiz) {
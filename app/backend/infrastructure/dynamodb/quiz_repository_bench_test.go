@@ -0,0 +1,108 @@
+//go:build integration
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"audio-slide-app/domain/model"
+)
+
+// ランダム出題のベンチマーク（DynamoDB Local が必要）
+//
+//	docker-compose up -d dynamodb-local dynamodb-init
+//	DYNAMODB_ENDPOINT=http://localhost:8000 AWS_ACCESS_KEY_ID=dummy AWS_SECRET_ACCESS_KEY=dummy \
+//	  go test -tags integration -run '^$' -bench BenchmarkQuizRepository_RandomQuizzes -benchtime 20x ./infrastructure/dynamodb/
+//
+// 初回は benchCategory に benchQuizCount 件のクイズを登録するため数分かかる（2回目以降は再利用する）
+
+const (
+	benchCategory  = "bench_100k"
+	benchQuizCount = 100000
+	benchPickCount = 10
+)
+
+func BenchmarkQuizRepository_RandomQuizzes(b *testing.B) {
+	endpoint := os.Getenv("DYNAMODB_ENDPOINT")
+	if endpoint == "" {
+		b.Skip("DYNAMODB_ENDPOINT is not set")
+	}
+
+	client, err := NewClientWithTimeout(endpoint, "ap-northeast-1", 5*time.Minute)
+	if err != nil {
+		b.Fatal(err)
+	}
+	repo := &QuizRepository{client: client}
+	ctx := context.Background()
+
+	seedBenchQuizzes(ctx, b, repo)
+
+	// randomKey の開始位置から必要な件数だけを読む
+	b.Run("RandomIndex", func(b *testing.B) {
+		itemsRead := 0
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			quizzes, err := repo.GetQuizzesByCategoryToData(ctx, benchCategory, benchPickCount)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(quizzes) != benchPickCount {
+				b.Fatalf("got %d quizzes, want %d", len(quizzes), benchPickCount)
+			}
+			itemsRead += len(quizzes)
+		}
+		b.ReportMetric(float64(itemsRead)/float64(b.N), "items-read/op")
+	})
+
+	// 従来の方式: パーティション全件を読み込んでシャッフルし、先頭を切り出す
+	b.Run("FullPartition", func(b *testing.B) {
+		itemsRead := 0
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			quizzes, err := repo.GetQuizzesByCategoryToData(ctx, benchCategory, 0)
+			if err != nil {
+				b.Fatal(err)
+			}
+			itemsRead += len(quizzes)
+			_ = quizzes[:benchPickCount]
+		}
+		b.ReportMetric(float64(itemsRead)/float64(b.N), "items-read/op")
+	})
+}
+
+// seedBenchQuizzes ベンチマーク用カテゴリに benchQuizCount 件のクイズを用意する
+func seedBenchQuizzes(ctx context.Context, b *testing.B, repo *QuizRepository) {
+	b.Helper()
+
+	count, err := repo.CountQuizzesByCategoryToData(ctx, benchCategory)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if count >= benchQuizCount {
+		return
+	}
+
+	b.Logf("seeding %d quizzes into category '%s'...", benchQuizCount, benchCategory)
+	const chunkSize = 1000
+	for offset := 0; offset < benchQuizCount; offset += chunkSize {
+		quizzes := make([]*model.Quiz, 0, chunkSize)
+		for i := offset; i < offset+chunkSize && i < benchQuizCount; i++ {
+			quizzes = append(quizzes, model.NewQuiz(
+				fmt.Sprintf("bench_quiz_%06d", i),
+				fmt.Sprintf("/images/bench/%06d.png", i),
+				fmt.Sprintf("/audio/bench/%06d.mp3", i),
+				"A",
+				[]string{"A", "B", "C", "D"},
+				benchCategory,
+				"",
+			))
+		}
+		if err := repo.BatchPut(ctx, quizzes); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package dynamodb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeClient X-Amz-Target の操作名ごとに固定のレスポンスを返す DynamoDB のダミーに接続する
+func newFakeClient(t *testing.T, responses map[string]func(w http.ResponseWriter)) *dynamodb.DynamoDB {
+	t.Helper()
+	client, _ := newRecordingFakeClient(t, responses)
+	return client
+}
+
+// newRecordingFakeClient newFakeClient に加えて、操作名ごとに最後に受け取ったリクエストの本文を記録する
+func newRecordingFakeClient(t *testing.T, responses map[string]func(w http.ResponseWriter)) (*dynamodb.DynamoDB, map[string]string) {
+	t.Helper()
+	var mu sync.Mutex
+	requests := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDB_20120810.")
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests[operation] = string(body)
+		mu.Unlock()
+		respond, ok := responses[operation]
+		if !ok {
+			t.Errorf("unexpected operation %s", operation)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		respond(w)
+	}))
+	t.Cleanup(server.Close)
+
+	client := dynamodb.New(session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("ap-northeast-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		MaxRetries:  aws.Int(0),
+	})))
+	return client, requests
+}
+
+func respondJSON(status int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+// transactionCanceled 指定した番号の項目だけが条件不一致となったトランザクションの取り消し
+func transactionCanceled(failedIndex, itemCount int) func(w http.ResponseWriter) {
+	reasons := make([]string, itemCount)
+	for i := range reasons {
+		reasons[i] = `{"Code":"None"}`
+	}
+	reasons[failedIndex] = `{"Code":"ConditionalCheckFailed","Message":"The conditional request failed"}`
+	return respondJSON(http.StatusBadRequest, `{"__type":"com.amazonaws.dynamodb.v20120810#TransactionCanceledException","Message":"Transaction cancelled","CancellationReasons":[`+strings.Join(reasons, ",")+`]}`)
+}
+
+func TestQuizRepository_Update_MoveCategory(t *testing.T) {
+	currentQuiz := respondJSON(http.StatusOK, `{"Count":1,"Items":[{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"QUIZ#quiz_001"},"id":{"S":"quiz_001"},"category":{"S":"flags"},"randomKey":{"S":"0123"}}]}`)
+
+	tests := []struct {
+		name     string
+		transact func(w http.ResponseWriter)
+		wantErr  bool
+		errType  string
+	}{
+		{
+			name:     "正常系",
+			transact: respondJSON(http.StatusOK, `{}`),
+			wantErr:  false,
+		},
+		{
+			name:     "異常系_同時に削除されたクイズ",
+			transact: transactionCanceled(0, 3),
+			wantErr:  true,
+			errType:  errs.EC002,
+		},
+		{
+			name:     "異常系_移動先に同じIDのクイズ",
+			transact: transactionCanceled(1, 3),
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+		{
+			name:     "異常系_移動先のカテゴリが存在しない",
+			transact: transactionCanceled(2, 3),
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+		{
+			name:     "異常系_DynamoDBエラー",
+			transact: respondJSON(http.StatusInternalServerError, `{"__type":"com.amazonaws.dynamodb.v20120810#InternalServerError","message":"internal"}`),
+			wantErr:  true,
+			errType:  errs.EC003,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewQuizRepository(newFakeClient(t, map[string]func(w http.ResponseWriter){
+				"Query":              currentQuiz,
+				"TransactWriteItems": tt.transact,
+			}))
+			quiz := model.NewQuiz("quiz_001", "images/animals/lion.png", "", "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+
+			err := repo.Update(context.Background(), quiz)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "0123", quiz.RandomKey)
+		})
+	}
+}
+
+func TestQuizRepository_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		transact func(w http.ResponseWriter)
+		wantErr  bool
+		errType  string
+	}{
+		{
+			name:     "正常系",
+			transact: respondJSON(http.StatusOK, `{}`),
+			wantErr:  false,
+		},
+		{
+			name:     "異常系_同じIDのクイズ",
+			transact: transactionCanceled(0, 2),
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+		{
+			name:     "異常系_カテゴリが存在しないか削除中",
+			transact: transactionCanceled(1, 2),
+			wantErr:  true,
+			errType:  errs.EC001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewQuizRepository(newFakeClient(t, map[string]func(w http.ResponseWriter){
+				"TransactWriteItems": tt.transact,
+			}))
+			quiz := model.NewQuiz("quiz_001", "images/animals/lion.png", "", "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+
+			err := repo.Create(context.Background(), quiz)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestQuizRepository_CountQuizzesPerCategoryToData(t *testing.T) {
+	tests := []struct {
+		name    string
+		scan    func(w http.ResponseWriter)
+		want    map[string]int
+		wantErr bool
+	}{
+		{
+			name: "正常系",
+			scan: respondJSON(http.StatusOK, `{"Count":3,"Items":[{"category":{"S":"flags"}},{"category":{"S":"animals"}},{"category":{"S":"flags"}}]}`),
+			want: map[string]int{"flags": 2, "animals": 1},
+		},
+		{
+			name: "正常系_クイズなし",
+			scan: respondJSON(http.StatusOK, `{"Count":0,"Items":[]}`),
+			want: map[string]int{},
+		},
+		{
+			name:    "異常系_読み込みエラー",
+			scan:    respondJSON(http.StatusBadRequest, `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &QuizRepository{client: newFakeClient(t, map[string]func(w http.ResponseWriter){
+				"Scan": tt.scan,
+			})}
+
+			counts, err := repo.CountQuizzesPerCategoryToData(context.Background())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, errs.EC003, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, counts)
+		})
+	}
+}
+
+func TestQuizRepository_GetQuizzesByIDsToData(t *testing.T) {
+	quizItem := func(id string) string {
+		return `{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"QUIZ#` + id + `"},"id":{"S":"` + id + `"},"category":{"S":"flags"}}`
+	}
+
+	tests := []struct {
+		name     string
+		ids      []string
+		batchGet func() func(w http.ResponseWriter)
+		wantIDs  []string
+		wantKeys int
+		wantErr  bool
+	}{
+		{
+			name: "正常系_指定した順に並べ、見つからないIDは除く",
+			ids:  []string{"quiz_003", "quiz_deleted", "quiz_001", "quiz_003"},
+			batchGet: func() func(w http.ResponseWriter) {
+				return respondJSON(http.StatusOK, `{"Responses":{"Quiz":[`+quizItem("quiz_001")+`,`+quizItem("quiz_003")+`]}}`)
+			},
+			wantIDs:  []string{"quiz_003", "quiz_001"},
+			wantKeys: 3,
+		},
+		{
+			name: "正常系_未処理のキーを再送する",
+			ids:  []string{"quiz_001", "quiz_002"},
+			batchGet: func() func(w http.ResponseWriter) {
+				calls := 0
+				return func(w http.ResponseWriter) {
+					calls++
+					if calls == 1 {
+						respondJSON(http.StatusOK, `{"Responses":{"Quiz":[`+quizItem("quiz_002")+`]},`+
+							`"UnprocessedKeys":{"Quiz":{"Keys":[{"PK":{"S":"CATEGORY#flags"},"SK":{"S":"QUIZ#quiz_001"}}]}}}`)(w)
+						return
+					}
+					respondJSON(http.StatusOK, `{"Responses":{"Quiz":[`+quizItem("quiz_001")+`]}}`)(w)
+				}
+			},
+			wantIDs:  []string{"quiz_001", "quiz_002"},
+			wantKeys: 1,
+		},
+		{
+			name: "異常系_読み込みエラー",
+			ids:  []string{"quiz_001"},
+			batchGet: func() func(w http.ResponseWriter) {
+				return respondJSON(http.StatusBadRequest, `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := newRecordingFakeClient(t, map[string]func(w http.ResponseWriter){
+				"BatchGetItem": tt.batchGet(),
+			})
+			repo := &QuizRepository{client: client}
+
+			quizzes, err := repo.GetQuizzesByIDsToData(context.Background(), "flags", tt.ids)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, errs.EC003, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			ids := make([]string, 0, len(quizzes))
+			for _, quiz := range quizzes {
+				ids = append(ids, quiz.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			// 最後のリクエストのキーの数（重複は1件にまとめ、再送は未処理のキーのみ）
+			assert.Equal(t, tt.wantKeys, strings.Count(requests["BatchGetItem"], `"SK"`))
+		})
+	}
+}
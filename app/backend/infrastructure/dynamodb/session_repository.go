@@ -0,0 +1,108 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+type SessionRepository struct {
+	client *dynamodb.DynamoDB
+}
+
+func NewSessionRepository(client *dynamodb.DynamoDB) repository.ISessionRepository {
+	return &SessionRepository{
+		client: client,
+	}
+}
+
+func (r *SessionRepository) GetSessionByIDToData(ctx context.Context, id string) (*model.QuizSession, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(QuizTableName),
+		Key: map[string]*dynamodb.AttributeValue{
+			"PK": {
+				S: aws.String(fmt.Sprintf("SESSION#%s", id)),
+			},
+			"SK": {
+				S: aws.String("META"),
+			},
+		},
+	}
+
+	result, err := r.client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get session: %w", err))
+	}
+
+	if result.Item == nil {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("session with id '%s' not found", id))
+	}
+
+	var session model.QuizSession
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &session); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal session: %w", err))
+	}
+
+	return &session, nil
+}
+
+func (r *SessionRepository) CreateSession(ctx context.Context, session *model.QuizSession) error {
+	if err := r.putSession(ctx, session, "attribute_not_exists(PK)", nil, nil); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to create session: %w", err))
+	}
+	return nil
+}
+
+// UpdateSession 取得時からバージョンが変わっていない場合のみ保存し、バージョンを1つ進める
+// 同じセッションへの同時回答では後から保存した側を EC001 とし、先に保存された回答を失わないようにする
+// 取得の後に TTL で削除されたセッションは保存し直さず EC002 とする
+func (r *SessionRepository) UpdateSession(ctx context.Context, session *model.QuizSession) error {
+	prev := session.Version
+	condition := "attribute_exists(PK) AND #version = :prev"
+	if prev == 0 {
+		// バージョンを持たない（楽観ロック導入前に作成された）セッションも更新できるようにする
+		condition = "attribute_exists(PK) AND (attribute_not_exists(#version) OR #version = :prev)"
+	}
+	names := map[string]*string{"#version": aws.String("version")}
+	values := map[string]*dynamodb.AttributeValue{":prev": {N: aws.String(strconv.Itoa(prev))}}
+
+	session.Version = prev + 1
+	if err := r.putSession(ctx, session, condition, names, values); err != nil {
+		session.Version = prev
+		if isConditionalCheckFailed(err) {
+			// 条件のどちらで失敗したかを読み直して判定する（削除済みは EC002、バージョンの不一致は EC001）
+			if _, getErr := r.GetSessionByIDToData(ctx, session.ID); getErr != nil {
+				return getErr
+			}
+			return errs.NewBadRequestError(fmt.Sprintf("session '%s' was updated by another request", session.ID))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to update session: %w", err))
+	}
+	return nil
+}
+
+func (r *SessionRepository) putSession(ctx context.Context, session *model.QuizSession, condition string, names map[string]*string, values map[string]*dynamodb.AttributeValue) error {
+	item, err := dynamodbattribute.MarshalMap(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	input := &dynamodb.PutItemInput{
+		TableName:                 aws.String(QuizTableName),
+		Item:                      item,
+		ConditionExpression:       aws.String(condition),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	}
+
+	_, err = r.client.PutItemWithContext(ctx, input)
+	return err
+}
@@ -0,0 +1,92 @@
+package dynamodb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRepository_UpdateSession(t *testing.T) {
+	conditionalCheckFailed := respondJSON(http.StatusBadRequest, `{"__type":"com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException","message":"The conditional request failed"}`)
+
+	tests := []struct {
+		name        string
+		version     int
+		responses   map[string]func(w http.ResponseWriter)
+		wantErr     bool
+		errType     string
+		wantVersion int
+	}{
+		{
+			name:    "正常系",
+			version: 2,
+			responses: map[string]func(w http.ResponseWriter){
+				"PutItem": respondJSON(http.StatusOK, `{}`),
+			},
+			wantErr:     false,
+			wantVersion: 3,
+		},
+		{
+			name:    "異常系_別のリクエストで更新された",
+			version: 2,
+			responses: map[string]func(w http.ResponseWriter){
+				"PutItem": conditionalCheckFailed,
+				"GetItem": respondJSON(http.StatusOK, `{"Item":{"PK":{"S":"SESSION#session_001"},"SK":{"S":"META"},"sessionId":{"S":"session_001"},"version":{"N":"3"}}}`),
+			},
+			wantErr:     true,
+			errType:     errs.EC001,
+			wantVersion: 2,
+		},
+		{
+			name:    "異常系_TTLで削除された",
+			version: 2,
+			responses: map[string]func(w http.ResponseWriter){
+				"PutItem": conditionalCheckFailed,
+				"GetItem": respondJSON(http.StatusOK, `{}`),
+			},
+			wantErr:     true,
+			errType:     errs.EC002,
+			wantVersion: 2,
+		},
+		{
+			name:    "異常系_バージョンを持たないセッションがTTLで削除された",
+			version: 0,
+			responses: map[string]func(w http.ResponseWriter){
+				"PutItem": conditionalCheckFailed,
+				"GetItem": respondJSON(http.StatusOK, `{}`),
+			},
+			wantErr:     true,
+			errType:     errs.EC002,
+			wantVersion: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := newRecordingFakeClient(t, tt.responses)
+			repo := NewSessionRepository(client)
+			session := model.NewQuizSession("session_001", "flags", "ja", []string{"quiz_flag_001"}, time.Now())
+			session.Version = tt.version
+
+			err := repo.UpdateSession(context.Background(), session)
+
+			// 削除されたセッションを保存し直さない
+			assert.Contains(t, requests["PutItem"], "attribute_exists(PK)")
+			assert.Equal(t, tt.wantVersion, session.Version)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if appErr, ok := err.(*errs.AppError); ok {
+					assert.Equal(t, tt.errType, appErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ユーザーは USER#<id>/PROFILE に保存し、メールアドレスの一意性と検索のため
+// EMAIL#<email>/META に userId を持つアイテムを同時に書き込む
+type UserRepository struct {
+	client *dynamodb.DynamoDB
+}
+
+func NewUserRepository(client *dynamodb.DynamoDB) repository.IUserRepository {
+	return &UserRepository{
+		client: client,
+	}
+}
+
+// emailItem メールアドレスからユーザーIDを引くためのアイテム
+type emailItem struct {
+	PK     string `dynamodbav:"PK"`
+	SK     string `dynamodbav:"SK"`
+	UserID string `dynamodbav:"userId"`
+}
+
+func (r *UserRepository) GetUserByIDToData(ctx context.Context, id string) (*model.User, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(QuizTableName),
+		Key:       quizKey(fmt.Sprintf("USER#%s", id), "PROFILE"),
+	}
+
+	result, err := r.client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get user: %w", err))
+	}
+
+	if result.Item == nil {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("user with id '%s' not found", id))
+	}
+
+	var user model.User
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &user); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal user: %w", err))
+	}
+
+	return &user, nil
+}
+
+func (r *UserRepository) GetUserByEmailToData(ctx context.Context, email string) (*model.User, error) {
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String(QuizTableName),
+		Key:       quizKey(emailPK(email), "META"),
+	}
+
+	result, err := r.client.GetItemWithContext(ctx, input)
+	if err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to get user by email: %w", err))
+	}
+
+	if result.Item == nil {
+		return nil, errs.NewNotFoundError("user with the email not found")
+	}
+
+	var item emailItem
+	if err := dynamodbattribute.UnmarshalMap(result.Item, &item); err != nil {
+		return nil, errs.NewInternalServerError(fmt.Errorf("failed to unmarshal email item: %w", err))
+	}
+
+	return r.GetUserByIDToData(ctx, item.UserID)
+}
+
+func (r *UserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	userItem, err := dynamodbattribute.MarshalMap(user)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal user: %w", err))
+	}
+	emailAttrs, err := dynamodbattribute.MarshalMap(emailItem{
+		PK:     emailPK(user.Email),
+		SK:     "META",
+		UserID: user.ID,
+	})
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal email item: %w", err))
+	}
+
+	input := &dynamodb.TransactWriteItemsInput{
+		TransactItems: []*dynamodb.TransactWriteItem{
+			{
+				Put: &dynamodb.Put{
+					TableName:           aws.String(QuizTableName),
+					Item:                emailAttrs,
+					ConditionExpression: aws.String("attribute_not_exists(PK)"),
+				},
+			},
+			{
+				Put: &dynamodb.Put{
+					TableName:           aws.String(QuizTableName),
+					Item:                userItem,
+					ConditionExpression: aws.String("attribute_not_exists(PK)"),
+				},
+			},
+		},
+	}
+
+	if _, err := r.client.TransactWriteItemsWithContext(ctx, input); err != nil {
+		if isTransactionConditionFailed(err) {
+			return errs.NewBadRequestError("email is already registered")
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to create user: %w", err))
+	}
+
+	return nil
+}
+
+func (r *UserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+	item, err := dynamodbattribute.MarshalMap(user)
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to marshal user: %w", err))
+	}
+
+	// メールアドレスの変更は扱わないため EMAIL# アイテムは更新しない
+	input := &dynamodb.PutItemInput{
+		TableName:           aws.String(QuizTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_exists(PK)"),
+	}
+
+	if _, err := r.client.PutItemWithContext(ctx, input); err != nil {
+		if isConditionalCheckFailed(err) {
+			return errs.NewNotFoundError(fmt.Sprintf("user with id '%s' not found", user.ID))
+		}
+		return errs.NewInternalServerError(fmt.Errorf("failed to update user: %w", err))
+	}
+
+	return nil
+}
+
+func emailPK(email string) string {
+	return fmt.Sprintf("EMAIL#%s", model.NormalizeEmail(email))
+}
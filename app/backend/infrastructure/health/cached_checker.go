@@ -0,0 +1,57 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"audio-slide-app/domain/service"
+)
+
+// CachedChecker 確認結果（失敗も含む）を短時間保持し、依存先への確認の頻度を抑える
+// /api/health/ready は公開されているため、DescribeTable などの制限の厳しい API を呼び出しごとに実行しない
+// 同時に呼ばれた場合は1回だけ確認し、他の呼び出しはその結果を使う
+// 呼び出し元の context がキャンセル・タイムアウトした確認の結果は依存先の状態を表さないため保持しない
+type CachedChecker struct {
+	checker service.IHealthChecker
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	err       error
+	expiresAt time.Time
+}
+
+// NewCachedChecker ttl が0以下の場合はキャッシュせず checker をそのまま返す
+func NewCachedChecker(checker service.IHealthChecker, ttl time.Duration) service.IHealthChecker {
+	if ttl <= 0 {
+		return checker
+	}
+	return &CachedChecker{
+		checker: checker,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+func (c *CachedChecker) Name() string {
+	return c.checker.Name()
+}
+
+func (c *CachedChecker) Check(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.now().Before(c.expiresAt) {
+		return c.err
+	}
+
+	err := c.checker.Check(ctx)
+	if ctx.Err() != nil {
+		return err
+	}
+
+	c.err = err
+	c.expiresAt = c.now().Add(c.ttl)
+	return err
+}
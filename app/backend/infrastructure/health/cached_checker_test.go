@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingChecker 呼び出し回数を数え、results の結果を順に返す
+type countingChecker struct {
+	calls   int
+	results []error
+}
+
+func (c *countingChecker) Name() string {
+	return "dynamodb"
+}
+
+func (c *countingChecker) Check(ctx context.Context) error {
+	err := c.results[c.calls]
+	c.calls++
+	return err
+}
+
+func TestCachedChecker_Check(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	unavailable := errors.New("ResourceNotFoundException")
+
+	tests := []struct {
+		name      string
+		results   []error
+		elapsed   []time.Duration
+		want      []error
+		wantCalls int
+	}{
+		{
+			name:      "正常系_保持期間内は確認しない",
+			results:   []error{nil},
+			elapsed:   []time.Duration{0, time.Second, 4 * time.Second},
+			want:      []error{nil, nil, nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "正常系_保持期間を過ぎたら確認し直す",
+			results:   []error{nil, unavailable},
+			elapsed:   []time.Duration{0, 5 * time.Second},
+			want:      []error{nil, unavailable},
+			wantCalls: 2,
+		},
+		{
+			name:      "異常系_失敗も保持する",
+			results:   []error{unavailable, nil},
+			elapsed:   []time.Duration{0, 2 * time.Second, 6 * time.Second},
+			want:      []error{unavailable, unavailable, nil},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &countingChecker{results: tt.results}
+			now := start
+			checker := &CachedChecker{checker: inner, ttl: 5 * time.Second, now: func() time.Time { return now }}
+
+			for i, elapsed := range tt.elapsed {
+				now = start.Add(elapsed)
+				assert.Equal(t, tt.want[i], checker.Check(context.Background()))
+			}
+			assert.Equal(t, tt.wantCalls, inner.calls)
+			assert.Equal(t, "dynamodb", checker.Name())
+		})
+	}
+}
+
+func TestCachedChecker_Check_CallerCanceled(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	inner := &countingChecker{results: []error{context.Canceled, nil}}
+	checker := &CachedChecker{checker: inner, ttl: 5 * time.Second, now: func() time.Time { return now }}
+
+	// 呼び出し元がキャンセルした確認の失敗は保持しない
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, checker.Check(ctx), context.Canceled)
+
+	// 保持期間内でも次の呼び出しで確認し直し、その結果を保持する
+	now = now.Add(time.Second)
+	assert.NoError(t, checker.Check(context.Background()))
+	assert.NoError(t, checker.Check(context.Background()))
+	assert.Equal(t, 2, inner.calls)
+}
+
+func TestNewCachedChecker(t *testing.T) {
+	inner := &countingChecker{}
+
+	assert.Same(t, inner, NewCachedChecker(inner, 0))
+	assert.IsType(t, &CachedChecker{}, NewCachedChecker(inner, 5*time.Second))
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSession 固定のレスポンスを返すダミーのエンドポイントに接続する
+func newSession(t *testing.T, status int, body string) *session.Session {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return session.Must(session.NewSession(&aws.Config{
+		Region:           aws.String("ap-northeast-1"),
+		Endpoint:         aws.String(server.URL),
+		Credentials:      credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		MaxRetries:       aws.Int(0),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+}
+
+func TestDynamoDBTableChecker_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "正常系_ACTIVE",
+			status: http.StatusOK,
+			body:   `{"Table":{"TableName":"Quiz","TableStatus":"ACTIVE"}}`,
+		},
+		{
+			name:   "正常系_UPDATING",
+			status: http.StatusOK,
+			body:   `{"Table":{"TableName":"Quiz","TableStatus":"UPDATING"}}`,
+		},
+		{
+			name:    "異常系_作成中",
+			status:  http.StatusOK,
+			body:    `{"Table":{"TableName":"Quiz","TableStatus":"CREATING"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "異常系_テーブルなし",
+			status:  http.StatusBadRequest,
+			body:    `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewDynamoDBTableChecker(dynamodb.New(newSession(t, tt.status, tt.body)), "Quiz")
+
+			err := checker.Check(context.Background())
+
+			assert.Equal(t, "dynamodb", checker.Name())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestS3BucketChecker_Check(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "正常系_バケットあり",
+			status: http.StatusOK,
+		},
+		{
+			name:    "異常系_バケットなし",
+			status:  http.StatusNotFound,
+			wantErr: true,
+		},
+		{
+			name:    "異常系_権限なし",
+			status:  http.StatusForbidden,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewS3BucketChecker(s3.New(newSession(t, tt.status, "")), "audio-slide-app-assets")
+
+			err := checker.Check(context.Background())
+
+			assert.Equal(t, "mediaBucket", checker.Name())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
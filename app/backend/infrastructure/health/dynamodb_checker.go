@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"audio-slide-app/domain/service"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// DynamoDBTableChecker DynamoDB のテーブルが存在し、読み書きできる状態かを DescribeTable で確認する
+type DynamoDBTableChecker struct {
+	client *dynamodb.DynamoDB
+	table  string
+}
+
+func NewDynamoDBTableChecker(client *dynamodb.DynamoDB, table string) service.IHealthChecker {
+	return &DynamoDBTableChecker{
+		client: client,
+		table:  table,
+	}
+}
+
+func (c *DynamoDBTableChecker) Name() string {
+	return "dynamodb"
+}
+
+func (c *DynamoDBTableChecker) Check(ctx context.Context) error {
+	output, err := c.client.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(c.table),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe table '%s': %w", c.table, err)
+	}
+
+	// UPDATING（容量・GSI の変更中）も読み書きはできる
+	switch status := aws.StringValue(output.Table.TableStatus); status {
+	case dynamodb.TableStatusActive, dynamodb.TableStatusUpdating:
+		return nil
+	default:
+		return fmt.Errorf("table '%s' is %s", c.table, status)
+	}
+}
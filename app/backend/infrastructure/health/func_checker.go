@@ -0,0 +1,28 @@
+package health
+
+import (
+	"context"
+
+	"audio-slide-app/domain/service"
+)
+
+// FuncChecker 関数で確認内容を指定する（設定値の検証など、外部に問い合わせない確認に使う）
+type FuncChecker struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func NewFuncChecker(name string, check func(ctx context.Context) error) service.IHealthChecker {
+	return &FuncChecker{
+		name:  name,
+		check: check,
+	}
+}
+
+func (c *FuncChecker) Name() string {
+	return c.name
+}
+
+func (c *FuncChecker) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
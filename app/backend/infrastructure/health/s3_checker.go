@@ -0,0 +1,39 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"audio-slide-app/domain/service"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3BucketChecker 画像・音声を保存する S3 バケットが存在し、アクセスできるかを HeadBucket で確認する
+type S3BucketChecker struct {
+	client *s3.S3
+	bucket string
+}
+
+func NewS3BucketChecker(client *s3.S3, bucket string) service.IHealthChecker {
+	return &S3BucketChecker{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+func (c *S3BucketChecker) Name() string {
+	return "mediaBucket"
+}
+
+func (c *S3BucketChecker) Check(ctx context.Context) error {
+	_, err := c.client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(c.bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to head bucket '%s': %w", c.bucket, err)
+	}
+
+	return nil
+}
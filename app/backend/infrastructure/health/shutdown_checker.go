@@ -0,0 +1,32 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// ShutdownChecker サーバーの停止を始めたら失敗を返し、ALB がこのタスクにリクエストを転送しないようにする
+type ShutdownChecker struct {
+	draining atomic.Bool
+}
+
+func NewShutdownChecker() *ShutdownChecker {
+	return &ShutdownChecker{}
+}
+
+// Drain 停止を始めたことを記録する（以降の Check は失敗する）
+func (c *ShutdownChecker) Drain() {
+	c.draining.Store(true)
+}
+
+func (c *ShutdownChecker) Name() string {
+	return "shutdown"
+}
+
+func (c *ShutdownChecker) Check(ctx context.Context) error {
+	if c.draining.Load() {
+		return errors.New("server is shutting down")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package media
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"audio-slide-app/domain/model"
+)
+
+// s3HostPattern S3 のエンドポイントのホスト名（s3.amazonaws.com / s3.<region>.amazonaws.com / s3-<region>.amazonaws.com など）
+var s3HostPattern = regexp.MustCompile(`^s3([.-][a-z0-9-]+)*\.amazonaws\.com$`)
+
+// bucketURL 署名付き URL の導入前に保存された、バケットの公開 URL からオブジェクトキーを取り出す
+// 各署名方式に埋め込み、同じバケットのオブジェクトを指す URL もオブジェクトキーと同様に署名できるようにする
+type bucketURL struct {
+	bucket string
+}
+
+// ObjectKeyFromURL 仮想ホスト形式（https://<bucket>.s3.<region>.amazonaws.com/<key>）と
+// パス形式（https://s3.<region>.amazonaws.com/<bucket>/<key>）の URL に対応する
+func (b bucketURL) ObjectKeyFromURL(rawURL string) (string, bool) {
+	if b.bucket == "" {
+		return "", false
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", false
+	}
+	host := strings.ToLower(u.Hostname())
+
+	var key string
+	switch {
+	case strings.HasPrefix(host, b.bucket+".") && s3HostPattern.MatchString(strings.TrimPrefix(host, b.bucket+".")):
+		key = strings.TrimPrefix(u.Path, "/")
+	case s3HostPattern.MatchString(host) && strings.HasPrefix(u.Path, "/"+b.bucket+"/"):
+		key = strings.TrimPrefix(u.Path, "/"+b.bucket+"/")
+	default:
+		return "", false
+	}
+
+	if !model.IsObjectKey(key) {
+		return "", false
+	}
+	return key, true
+}
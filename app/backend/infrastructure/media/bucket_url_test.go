@@ -0,0 +1,97 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketURL_ObjectKeyFromURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		bucket  string
+		rawURL  string
+		wantKey string
+		wantOK  bool
+	}{
+		{
+			name:    "正常系_リージョン付きの仮想ホスト形式",
+			bucket:  "audio-slide-app-assets",
+			rawURL:  "https://audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com/images/flags/jp.png",
+			wantKey: "images/flags/jp.png",
+			wantOK:  true,
+		},
+		{
+			name:    "正常系_リージョンなしの仮想ホスト形式",
+			bucket:  "audio-slide-app-assets",
+			rawURL:  "https://audio-slide-app-assets.s3.amazonaws.com/audio/flags/jp.mp3",
+			wantKey: "audio/flags/jp.mp3",
+			wantOK:  true,
+		},
+		{
+			name:    "正常系_ハイフン区切りのリージョン",
+			bucket:  "audio-slide-app-assets",
+			rawURL:  "https://audio-slide-app-assets.s3-ap-northeast-1.amazonaws.com/images/flags/jp.png",
+			wantKey: "images/flags/jp.png",
+			wantOK:  true,
+		},
+		{
+			name:    "正常系_パス形式",
+			bucket:  "audio-slide-app-assets",
+			rawURL:  "https://s3.ap-northeast-1.amazonaws.com/audio-slide-app-assets/images/flags/jp.png",
+			wantKey: "images/flags/jp.png",
+			wantOK:  true,
+		},
+		{
+			name:    "正常系_クエリ文字列は無視",
+			bucket:  "audio-slide-app-assets",
+			rawURL:  "https://audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com/images/flags/jp.png?X-Amz-Expires=900",
+			wantKey: "images/flags/jp.png",
+			wantOK:  true,
+		},
+		{
+			name:   "異常系_別のバケット",
+			bucket: "audio-slide-app-assets",
+			rawURL: "https://other-bucket.s3.ap-northeast-1.amazonaws.com/images/flags/jp.png",
+		},
+		{
+			name:   "異常系_バケット名を含む別のホスト",
+			bucket: "audio-slide-app-assets",
+			rawURL: "https://audio-slide-app-assets.s3.example.com/images/flags/jp.png",
+		},
+		{
+			name:   "異常系_パス形式の別のバケット",
+			bucket: "audio-slide-app-assets",
+			rawURL: "https://s3.ap-northeast-1.amazonaws.com/audio-slide-app-assets-old/images/flags/jp.png",
+		},
+		{
+			name:   "異常系_外部の配信先",
+			bucket: "audio-slide-app-assets",
+			rawURL: "https://cdn.example.com/images/flags/jp.png",
+		},
+		{
+			name:   "異常系_オブジェクトキーとして不正なパス",
+			bucket: "audio-slide-app-assets",
+			rawURL: "https://audio-slide-app-assets.s3.amazonaws.com/images/../secret.png",
+		},
+		{
+			name:   "異常系_パスのみ",
+			bucket: "audio-slide-app-assets",
+			rawURL: "/images/flags/jp.png",
+		},
+		{
+			name:   "異常系_バケット未設定",
+			bucket: "",
+			rawURL: "https://s3.amazonaws.com//images/flags/jp.png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, ok := bucketURL{bucket: tt.bucket}.ObjectKeyFromURL(tt.rawURL)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package media
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"strings"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/service"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront/sign"
+)
+
+// CloudFrontSigner CloudFront の署名付き URL（canned policy）を発行する
+type CloudFrontSigner struct {
+	bucketURL
+	baseURL string
+	signer  *sign.URLSigner
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// NewCloudFrontSigner domain は https:// を省略した配信ドメイン（例: d111111abcdef8.cloudfront.net）
+// bucket は配信元のバケットで、その公開 URL で保存された参照も CloudFront の URL で署名する
+func NewCloudFrontSigner(domain, keyPairID string, privateKey *rsa.PrivateKey, bucket string, ttl time.Duration) service.IMediaSigner {
+	return &CloudFrontSigner{
+		bucketURL: bucketURL{bucket: bucket},
+		baseURL:   "https://" + strings.TrimSuffix(domain, "/"),
+		signer:    sign.NewURLSigner(keyPairID, privateKey),
+		ttl:       ttl,
+		now:       time.Now,
+	}
+}
+
+// LoadCloudFrontPrivateKey CloudFront のキーペアの秘密鍵（PEM）を読み込む
+func LoadCloudFrontPrivateKey(path string) (*rsa.PrivateKey, error) {
+	return sign.LoadPEMPrivKeyFile(path)
+}
+
+func (s *CloudFrontSigner) SignURL(ctx context.Context, key string) (string, error) {
+	url, err := s.signer.Sign(s.baseURL+"/"+key, s.now().Add(s.ttl))
+	if err != nil {
+		return "", errs.NewInternalServerError(fmt.Errorf("failed to sign '%s': %w", key, err))
+	}
+
+	return url, nil
+}
@@ -0,0 +1,38 @@
+package media
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront/sign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudFrontSigner_SignURL(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	signer := &CloudFrontSigner{
+		baseURL: "https://d111111abcdef8.cloudfront.net",
+		signer:  sign.NewURLSigner("K2JCJMDEHXQW5F", privateKey),
+		ttl:     10 * time.Minute,
+		now:     func() time.Time { return now },
+	}
+
+	signed, err := signer.SignURL(context.Background(), "audio/flags/jp.mp3")
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "d111111abcdef8.cloudfront.net", parsed.Host)
+	assert.Equal(t, "/audio/flags/jp.mp3", parsed.Path)
+	assert.Equal(t, "K2JCJMDEHXQW5F", parsed.Query().Get("Key-Pair-Id"))
+	assert.Equal(t, strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10), parsed.Query().Get("Expires"))
+	assert.NotEmpty(t, parsed.Query().Get("Signature"))
+}
@@ -0,0 +1,55 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/service"
+)
+
+// LocalStorage 画像・音声ファイルをローカルのディレクトリに保存する（DynamoDB Local を使う開発環境向け）
+// 保存したファイルは MEDIA_SIGNER=public と組み合わせて API サーバーの /media から配信する
+type LocalStorage struct {
+	dir string
+}
+
+func NewLocalStorage(dir string) service.IMediaStorage {
+	return &LocalStorage{
+		dir: dir,
+	}
+}
+
+// Put 書き込み途中のファイルが配信されないよう、一時ファイルに書き込んでから置き換える
+func (s *LocalStorage) Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to create directory for '%s': %w", key, err))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to create file for '%s': %w", key, err))
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return errs.NewInternalServerError(fmt.Errorf("failed to write '%s': %w", key, err))
+	}
+	if err := tmp.Close(); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to write '%s': %w", key, err))
+	}
+	// CreateTemp は 0600 で作成するため、配信できるよう読み取り権限を付ける
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to write '%s': %w", key, err))
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to write '%s': %w", key, err))
+	}
+
+	return nil
+}
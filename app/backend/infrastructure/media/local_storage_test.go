@@ -0,0 +1,38 @@
+package media
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage_Put(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewLocalStorage(dir)
+
+	t.Run("正常系_ディレクトリを作成して保存", func(t *testing.T) {
+		err := storage.Put(context.Background(), "images/flags/jp.png", strings.NewReader("png"), "image/png")
+
+		assert.NoError(t, err)
+		body, err := os.ReadFile(filepath.Join(dir, "images", "flags", "jp.png"))
+		assert.NoError(t, err)
+		assert.Equal(t, "png", string(body))
+	})
+
+	t.Run("正常系_同じキーは上書き", func(t *testing.T) {
+		err := storage.Put(context.Background(), "images/flags/jp.png", strings.NewReader("png2"), "image/png")
+
+		assert.NoError(t, err)
+		body, err := os.ReadFile(filepath.Join(dir, "images", "flags", "jp.png"))
+		assert.NoError(t, err)
+		assert.Equal(t, "png2", string(body))
+		// 一時ファイルは残さない
+		entries, err := os.ReadDir(filepath.Join(dir, "images", "flags"))
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
@@ -0,0 +1,26 @@
+package media
+
+import (
+	"context"
+	"strings"
+
+	"audio-slide-app/domain/service"
+)
+
+// PublicURLSigner 公開されたバケットや開発用の配信先の URL をそのまま組み立てる（署名しない）
+type PublicURLSigner struct {
+	bucketURL
+	baseURL string
+}
+
+// NewPublicURLSigner bucket の公開 URL で保存された参照も baseURL の URL に組み立て直す
+func NewPublicURLSigner(baseURL, bucket string) service.IMediaSigner {
+	return &PublicURLSigner{
+		bucketURL: bucketURL{bucket: bucket},
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *PublicURLSigner) SignURL(ctx context.Context, key string) (string, error) {
+	return s.baseURL + "/" + key, nil
+}
@@ -0,0 +1,28 @@
+package media
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicURLSigner_SignURL(t *testing.T) {
+	signer := NewPublicURLSigner("https://audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com/", "audio-slide-app-assets")
+
+	signed, err := signer.SignURL(context.Background(), "images/flags/jp.png")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com/images/flags/jp.png", signed)
+}
+
+func TestPublicURLSigner_ObjectKeyFromURL(t *testing.T) {
+	signer := NewPublicURLSigner("http://localhost:8080/media", "audio-slide-app-assets")
+
+	key, ok := signer.ObjectKeyFromURL("https://audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com/images/flags/jp.png")
+	assert.True(t, ok)
+	signed, err := signer.SignURL(context.Background(), key)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/media/images/flags/jp.png", signed)
+}
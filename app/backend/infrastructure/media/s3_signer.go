@@ -0,0 +1,58 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/service"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Signer 非公開のバケットのオブジェクトに S3 の署名付き URL を発行する
+type S3Signer struct {
+	bucketURL
+	client *s3.S3
+	bucket string
+	ttl    time.Duration
+}
+
+func NewS3Client(region string) (*s3.S3, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(region),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s3.New(sess), nil
+}
+
+func NewS3Signer(client *s3.S3, bucket string, ttl time.Duration) service.IMediaSigner {
+	return &S3Signer{
+		bucketURL: bucketURL{bucket: bucket},
+		client:    client,
+		bucket:    bucket,
+		ttl:       ttl,
+	}
+}
+
+// SignURL 署名はローカルで計算するため、S3 への通信は発生しない
+func (s *S3Signer) SignURL(ctx context.Context, key string) (string, error) {
+	req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	req.SetContext(ctx)
+
+	url, err := req.Presign(s.ttl)
+	if err != nil {
+		return "", errs.NewInternalServerError(fmt.Errorf("failed to presign '%s': %w", key, err))
+	}
+
+	return url, nil
+}
@@ -0,0 +1,33 @@
+package media
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS3Signer_SignURL(t *testing.T) {
+	// 署名はローカルで計算されるため、固定の認証情報で AWS に接続せずに検証できる
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("ap-northeast-1"),
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+	}))
+	signer := NewS3Signer(s3.New(sess), "audio-slide-app-assets", 15*time.Minute)
+
+	signed, err := signer.SignURL(context.Background(), "images/flags/jp.png")
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "audio-slide-app-assets.s3.ap-northeast-1.amazonaws.com", parsed.Host)
+	assert.Equal(t, "/images/flags/jp.png", parsed.Path)
+	assert.Equal(t, "900", parsed.Query().Get("X-Amz-Expires"))
+	assert.NotEmpty(t, parsed.Query().Get("X-Amz-Signature"))
+}
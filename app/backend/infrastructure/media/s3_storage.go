@@ -0,0 +1,40 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/service"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Storage 画像・音声ファイルを S3 バケットに保存する
+type S3Storage struct {
+	client *s3.S3
+	bucket string
+}
+
+func NewS3Storage(client *s3.S3, bucket string) service.IMediaStorage {
+	return &S3Storage{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return errs.NewInternalServerError(fmt.Errorf("failed to put '%s': %w", key, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"audio-slide-app/common/logging"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+// CachedCategoryRepository カテゴリ一覧を短時間メモリに保持するリポジトリ
+// 他のプロセスで追加されたカテゴリは、一覧にはTTL経過後に反映される（IDでの取得には即座に反映される）
+// 自プロセスでの書き込み時はキャッシュを破棄する
+type CachedCategoryRepository struct {
+	repo repository.ICategoryRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu         sync.Mutex
+	categories []*model.Category
+	expiresAt  time.Time
+}
+
+// NewCachedCategoryRepository ttl が0以下の場合はキャッシュせず repo をそのまま返す
+func NewCachedCategoryRepository(repo repository.ICategoryRepository, ttl time.Duration) repository.ICategoryRepository {
+	if ttl <= 0 {
+		return repo
+	}
+	return &CachedCategoryRepository{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+func (r *CachedCategoryRepository) GetCategoriesToData(ctx context.Context) ([]*model.Category, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.categories != nil && r.now().Before(r.expiresAt) {
+		return r.categories, nil
+	}
+
+	categories, err := r.repo.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.categories = categories
+	r.expiresAt = r.now().Add(r.ttl)
+	logging.FromContext(ctx).Debug("category cache refreshed", "categories", len(categories), "ttl", r.ttl.String())
+	return categories, nil
+}
+
+func (r *CachedCategoryRepository) GetCategoryByIDToData(ctx context.Context, id string) (*model.Category, error) {
+	categories, err := r.GetCategoriesToData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, category := range categories {
+		if category.ID == id {
+			return category, nil
+		}
+	}
+
+	// キャッシュにない場合は、前回の取得の後に他のプロセスで追加されたカテゴリかをリポジトリで確認する
+	category, err := r.repo.GetCategoryByIDToData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 一覧が古くなっているため破棄し、次回の取得で追加されたカテゴリを含めて読み込み直す
+	r.Invalidate()
+	return category, nil
+}
+
+func (r *CachedCategoryRepository) Create(ctx context.Context, category *model.Category) error {
+	defer r.Invalidate()
+	return r.repo.Create(ctx, category)
+}
+
+func (r *CachedCategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	defer r.Invalidate()
+	return r.repo.Update(ctx, category)
+}
+
+func (r *CachedCategoryRepository) Delete(ctx context.Context, id string) error {
+	defer r.Invalidate()
+	return r.repo.Delete(ctx, id)
+}
+
+// MarkDeleting 削除中のカテゴリは一覧に含めないため、キャッシュを破棄する
+func (r *CachedCategoryRepository) MarkDeleting(ctx context.Context, id string) error {
+	defer r.Invalidate()
+	return r.repo.MarkDeleting(ctx, id)
+}
+
+func (r *CachedCategoryRepository) UnmarkDeleting(ctx context.Context, id string) error {
+	defer r.Invalidate()
+	return r.repo.UnmarkDeleting(ctx, id)
+}
+
+func (r *CachedCategoryRepository) BatchPut(ctx context.Context, categories []*model.Category) error {
+	defer r.Invalidate()
+	return r.repo.BatchPut(ctx, categories)
+}
+
+// Invalidate キャッシュを破棄し、次回の取得でリポジトリから再読み込みさせる
+func (r *CachedCategoryRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.categories = nil
+	r.expiresAt = time.Time{}
+}
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedCategoryRepository_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockICategoryRepository(ctrl)
+
+	// TTLが0の場合はキャッシュを挟まない
+	assert.Equal(t, mockRepo, NewCachedCategoryRepository(mockRepo, 0))
+}
+
+func TestCachedCategoryRepository_GetCategoriesToData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	repo := &CachedCategoryRepository{
+		repo: mockRepo,
+		ttl:  30 * time.Second,
+		now:  func() time.Time { return now },
+	}
+
+	first := []*model.Category{model.NewCategory("flags", "国旗", "", "")}
+	second := []*model.Category{
+		model.NewCategory("flags", "国旗", "", ""),
+		model.NewCategory("instruments", "楽器", "", ""),
+	}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetCategoriesToData(gomock.Any()).Return(first, nil).Times(1),
+		mockRepo.EXPECT().GetCategoriesToData(gomock.Any()).Return(second, nil).Times(1),
+	)
+
+	// 初回はリポジトリから取得
+	got, err := repo.GetCategoriesToData(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, first, got)
+
+	// TTL内はキャッシュから返却
+	now = now.Add(10 * time.Second)
+	got, err = repo.GetCategoriesToData(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, first, got)
+
+	// TTL経過後は再取得し、追加されたカテゴリが反映される
+	now = now.Add(30 * time.Second)
+	category, err := repo.GetCategoryByIDToData(context.Background(), "instruments")
+	assert.NoError(t, err)
+	assert.Equal(t, "instruments", category.ID)
+}
+
+func TestCachedCategoryRepository_GetCategoryByIDToData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	now := time.Date(2025, 7, 4, 13, 0, 0, 0, time.UTC)
+	repo := &CachedCategoryRepository{
+		repo: mockRepo,
+		ttl:  30 * time.Second,
+		now:  func() time.Time { return now },
+	}
+
+	flags := model.NewCategory("flags", "国旗", "", "")
+	instruments := model.NewCategory("instruments", "楽器", "", "")
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetCategoriesToData(gomock.Any()).Return([]*model.Category{flags}, nil).Times(1),
+		mockRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "unknown").
+			Return(nil, errs.NewNotFoundError("category with id 'unknown' not found")).Times(1),
+		// キャッシュの取得後に他のプロセスで追加されたカテゴリ
+		mockRepo.EXPECT().GetCategoryByIDToData(gomock.Any(), "instruments").Return(instruments, nil).Times(1),
+		mockRepo.EXPECT().GetCategoriesToData(gomock.Any()).Return([]*model.Category{flags, instruments}, nil).Times(1),
+	)
+
+	// キャッシュを読み込む
+	category, err := repo.GetCategoryByIDToData(context.Background(), "flags")
+	assert.NoError(t, err)
+	assert.Equal(t, flags, category)
+
+	// キャッシュにもリポジトリにもないカテゴリは見つからないエラー
+	now = now.Add(10 * time.Second)
+	_, err = repo.GetCategoryByIDToData(context.Background(), "unknown")
+	assert.Error(t, err)
+	if appErr, ok := err.(*errs.AppError); ok {
+		assert.Equal(t, errs.EC002, appErr.Code)
+	}
+
+	// TTL内でも、キャッシュにないカテゴリはリポジトリから取得する
+	category, err = repo.GetCategoryByIDToData(context.Background(), "instruments")
+	assert.NoError(t, err)
+	assert.Equal(t, instruments, category)
+
+	// 古い一覧は破棄され、追加されたカテゴリを含めて読み込み直す（以降はキャッシュから返却）
+	got, err := repo.GetCategoriesToData(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.Category{flags, instruments}, got)
+	category, err = repo.GetCategoryByIDToData(context.Background(), "instruments")
+	assert.NoError(t, err)
+	assert.Equal(t, instruments, category)
+}
+
+func TestCachedCategoryRepository_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockICategoryRepository(ctrl)
+	repo := NewCachedCategoryRepository(mockRepo, time.Minute)
+
+	// エラー時はキャッシュせず、次回も再取得する
+	mockRepo.EXPECT().
+		GetCategoriesToData(gomock.Any()).
+		Return(nil, errors.New("database error")).
+		Times(2)
+
+	_, err := repo.GetCategoriesToData(context.Background())
+	assert.Error(t, err)
+	_, err = repo.GetCategoriesToData(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+// InstrumentDynamoDB 呼び出しごとの処理時間とスロットリングを記録する DynamoDB クライアントを返す
+// 元のクライアントのハンドラーは変更しないため、計測しないクライアントと併用できる
+func (m *Metrics) InstrumentDynamoDB(client *dynamodb.DynamoDB) *dynamodb.DynamoDB {
+	instrumented := *client.Client
+	instrumented.Handlers = client.Handlers.Copy()
+
+	// Retry は失敗した試行ごとに呼ばれるため、再試行で成功した場合もスロットリングを数えられる
+	instrumented.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "metrics.DynamoDBThrottles",
+		Fn: func(r *request.Request) {
+			if request.IsErrorThrottle(r.Error) {
+				m.dynamoDBThrottles.WithLabelValues(r.Operation.Name).Inc()
+			}
+		},
+	})
+	// Complete は再試行を含めて呼び出しが終わったときに1回だけ呼ばれる
+	instrumented.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "metrics.DynamoDBDuration",
+		Fn: func(r *request.Request) {
+			outcome := "success"
+			if r.Error != nil {
+				outcome = "error"
+			}
+			m.dynamoDBDuration.WithLabelValues(r.Operation.Name, outcome).Observe(time.Since(r.Time).Seconds())
+		},
+	})
+
+	return &dynamodb.DynamoDB{Client: &instrumented}
+}
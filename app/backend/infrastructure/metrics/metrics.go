@@ -0,0 +1,78 @@
+// Package metrics Prometheus 形式のメトリクス（HTTP リクエスト、エラーコード、DynamoDB の呼び出し）
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "audio_slide"
+
+// Metrics アプリケーションのメトリクス
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequestDuration *prometheus.HistogramVec
+	appErrors           *prometheus.CounterVec
+	dynamoDBDuration    *prometheus.HistogramVec
+	dynamoDBThrottles   *prometheus.CounterVec
+}
+
+// New メトリクスを専用のレジストリに登録して生成する（Go ランタイムとプロセスのメトリクスも含む）
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by Gin route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		appErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "app_errors_total",
+			Help:      "Error responses by application error code (EC001-EC005).",
+		}, []string{"code"}),
+		dynamoDBDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "dynamodb_request_duration_seconds",
+			Help:      "DynamoDB call latency by operation, including SDK retries.",
+			Buckets:   []float64{.002, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"operation", "outcome"}),
+		dynamoDBThrottles: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dynamodb_throttled_requests_total",
+			Help:      "DynamoDB attempts rejected by throttling, by operation.",
+		}, []string{"operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequestDuration,
+		m.appErrors,
+		m.dynamoDBDuration,
+		m.dynamoDBThrottles,
+	)
+	return m
+}
+
+// Handler /metrics で公開する HTTP ハンドラー
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTPRequest リクエストの処理時間を記録する（route は Gin のルートのパターン。一致しない場合は呼び出し側で固定のラベルにする）
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	m.httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// IncAppError エラーレスポンスをエラーコードごとに数える
+func (m *Metrics) IncAppError(code string) {
+	m.appErrors.WithLabelValues(code).Inc()
+}
@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.ObserveHTTPRequest(http.MethodGet, "/api/quiz/:id", http.StatusNotFound, 20*time.Millisecond)
+	m.IncAppError("EC002")
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body := rec.Body.String()
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, body, `audio_slide_http_request_duration_seconds_count{method="GET",route="/api/quiz/:id",status="404"} 1`)
+	assert.Contains(t, body, `audio_slide_app_errors_total{code="EC002"} 1`)
+}
+
+func TestMetrics_InstrumentDynamoDB(t *testing.T) {
+	// 1回目はスロットリング、2回目は成功を返す DynamoDB のダミー
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ProvisionedThroughputExceededException","message":"throttled"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("ap-northeast-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		MaxRetries:  aws.Int(1),
+	}))
+	client := dynamodb.New(sess)
+	m := New()
+	instrumented := m.InstrumentDynamoDB(client)
+
+	_, err := instrumented.GetItemWithContext(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String("Quiz"),
+		Key:       map[string]*dynamodb.AttributeValue{"PK": {S: aws.String("CATEGORY#flags")}, "SK": {S: aws.String("META")}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.dynamoDBThrottles.WithLabelValues("GetItem")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.dynamoDBDuration))
+	assert.Contains(t, describe(t, m), `audio_slide_dynamodb_request_duration_seconds_count{operation="GetItem",outcome="success"} 1`)
+
+	// 元のクライアントには計測のハンドラーを追加しない
+	assert.Equal(t, client.Handlers.Complete.Len()+1, instrumented.Handlers.Complete.Len())
+}
+
+func describe(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return rec.Body.String()
+}
@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type dynamoDBSpanKey struct{}
+
+// InstrumentDynamoDB 呼び出しごとにスパンを作成する DynamoDB クライアントを返す
+// スパンはリポジトリに渡された context のスパン（ユースケースなど）の子になる
+// 元のクライアントのハンドラーは変更しないため、計測しないクライアントと併用できる
+func InstrumentDynamoDB(client *dynamodb.DynamoDB, tp trace.TracerProvider) *dynamodb.DynamoDB {
+	tracer := tp.Tracer(InstrumentationName)
+
+	instrumented := *client.Client
+	instrumented.Handlers = client.Handlers.Copy()
+
+	// Validate は再試行を含めて1回だけ、送信の前に呼ばれる
+	instrumented.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tracing.StartDynamoDBSpan",
+		Fn: func(r *request.Request) {
+			attrs := []attribute.KeyValue{
+				semconv.DBSystemDynamoDB,
+				semconv.DBOperationName(r.Operation.Name),
+			}
+			if tables := tableNames(r.Params); len(tables) > 0 {
+				attrs = append(attrs, semconv.AWSDynamoDBTableNames(tables...))
+			}
+
+			ctx, span := tracer.Start(r.Context(), "DynamoDB."+r.Operation.Name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			r.SetContext(context.WithValue(ctx, dynamoDBSpanKey{}, span))
+		},
+	})
+	// Complete は再試行を含めて呼び出しが終わったときに1回だけ呼ばれる
+	instrumented.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tracing.EndDynamoDBSpan",
+		Fn: func(r *request.Request) {
+			// Validate の前に失敗した場合はスパンを作成していない
+			span, ok := r.Context().Value(dynamoDBSpanKey{}).(trace.Span)
+			if !ok {
+				return
+			}
+
+			span.SetAttributes(attribute.Int("aws.retry_count", r.RetryCount))
+			if r.HTTPResponse != nil {
+				span.SetAttributes(semconv.HTTPResponseStatusCode(r.HTTPResponse.StatusCode))
+			}
+			if r.Error != nil {
+				span.RecordError(r.Error)
+				span.SetStatus(codes.Error, r.Error.Error())
+			}
+			span.End()
+		},
+	})
+
+	return &dynamodb.DynamoDB{Client: &instrumented}
+}
+
+// tableNames リクエストのパラメータからテーブル名を取り出す（BatchWriteItem などテーブル名を持たない操作は空）
+func tableNames(params interface{}) []string {
+	values, err := awsutil.ValuesAtPath(params, "TableName")
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, value := range values {
+		if name, ok := value.(*string); ok && name != nil {
+			names = append(names, *name)
+		}
+	}
+	return names
+}
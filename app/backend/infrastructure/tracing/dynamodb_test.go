@@ -0,0 +1,90 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestInstrumentDynamoDB(t *testing.T) {
+	// テーブル名が Missing の場合はエラー、それ以外は空のアイテムを返す DynamoDB のダミー
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"Missing"`) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"table not found"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("ap-northeast-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""),
+		MaxRetries:  aws.Int(0),
+	}))
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	client := InstrumentDynamoDB(dynamodb.New(sess), provider)
+
+	tests := []struct {
+		name       string
+		table      string
+		wantErr    bool
+		wantStatus codes.Code
+	}{
+		{
+			name:       "正常系",
+			table:      "Quiz",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "異常系_DynamoDBのエラー",
+			table:      "Missing",
+			wantErr:    true,
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			ctx, parent := provider.Tracer("test").Start(context.Background(), "QuizUseCase.GetQuizByID")
+			_, err := client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+				TableName: aws.String(tt.table),
+				Key:       map[string]*dynamodb.AttributeValue{"PK": {S: aws.String("QUIZ#quiz_flag_001")}, "SK": {S: aws.String("META")}},
+			})
+			parent.End()
+
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			spans := exporter.GetSpans()
+			if assert.Len(t, spans, 2) {
+				span := spans[0]
+				assert.Equal(t, "DynamoDB.GetItem", span.Name)
+				// リポジトリに渡された context のスパンの子になる
+				assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+				assert.Contains(t, span.Attributes, semconv.DBSystemDynamoDB)
+				assert.Contains(t, span.Attributes, semconv.AWSDynamoDBTableNames(tt.table))
+				assert.Equal(t, tt.wantStatus, span.Status.Code)
+			}
+		})
+	}
+}
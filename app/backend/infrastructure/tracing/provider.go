@@ -0,0 +1,38 @@
+// Package tracing OpenTelemetry のトレースの出力先の設定と DynamoDB の呼び出しの計測
+package tracing
+
+import (
+	"context"
+	"io"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// InstrumentationName アプリケーションが作成するスパンの計装名
+const InstrumentationName = "audio-slide-app"
+
+// NewOTLPExporter OTLP（HTTP）でコレクターにスパンを送信する
+// 送信先は SDK が環境変数から決定する（OTEL_EXPORTER_OTLP_TRACES_ENDPOINT はそのままの URL、
+// OTEL_EXPORTER_OTLP_ENDPOINT は末尾に /v1/traces を付けた URL。どちらも未設定なら http://localhost:4318/v1/traces）
+func NewOTLPExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	return otlptracehttp.New(ctx)
+}
+
+// NewStdoutExporter スパンを JSON で書き出す（ローカルでの確認用）
+func NewStdoutExporter(w io.Writer) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(w))
+}
+
+// NewTracerProvider スパンをまとめて exporter に送信する TracerProvider を生成する
+// sampleRatio はトレースを記録する割合（呼び出し元でサンプリングされたトレースは常に記録する）
+func NewTracerProvider(exporter sdktrace.SpanExporter, serviceName string, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+}
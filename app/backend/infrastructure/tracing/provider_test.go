@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOTLPExporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		wantPath string
+	}{
+		{
+			name:     "正常系_ベースの URL にはシグナルのパスを付ける",
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": ""},
+			wantPath: "/v1/traces",
+		},
+		{
+			name:     "正常系_トレース用の URL はそのまま使う",
+			env:      map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "/custom/traces"},
+			wantPath: "/custom/traces",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths := make(chan string, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths <- r.URL.Path
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+			// 環境変数の値はコレクターの URL に続けて指定する
+			for key, value := range tt.env {
+				t.Setenv(key, server.URL+value)
+			}
+
+			exporter, err := NewOTLPExporter(context.Background())
+			require.NoError(t, err)
+			provider := NewTracerProvider(exporter, "test", 1)
+			_, span := provider.Tracer(InstrumentationName).Start(context.Background(), "test")
+			span.End()
+
+			require.NoError(t, provider.Shutdown(context.Background()))
+			assert.Equal(t, tt.wantPath, <-paths)
+		})
+	}
+}
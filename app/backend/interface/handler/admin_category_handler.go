@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminCategoryHandler struct {
+	categoryAdminUseCase usecase.ICategoryAdminUseCase
+}
+
+func NewAdminCategoryHandler(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) *AdminCategoryHandler {
+	categoryAdminUseCase := usecase.NewCategoryAdminUseCase(categoryRepo, quizRepo)
+	return &AdminCategoryHandler{
+		categoryAdminUseCase: categoryAdminUseCase,
+	}
+}
+
+func (h *AdminCategoryHandler) CreateCategory(c *gin.Context) {
+	var req dto.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	category, err := h.categoryAdminUseCase.CreateCategory(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+func (h *AdminCategoryHandler) UpdateCategory(c *gin.Context) {
+	var req dto.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	category, err := h.categoryAdminUseCase.UpdateCategory(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+func (h *AdminCategoryHandler) DeleteCategory(c *gin.Context) {
+	cascade := false
+	if cascadeStr := c.Query("cascade"); cascadeStr != "" {
+		parsed, err := strconv.ParseBool(cascadeStr)
+		if err != nil {
+			HandleError(c, errs.NewBadRequestError("cascade must be a boolean"))
+			return
+		}
+		cascade = parsed
+	}
+
+	if err := h.categoryAdminUseCase.DeleteCategory(c.Request.Context(), c.Param("id"), cascade); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxMediaRequestSize アップロードのリクエスト全体の上限（最大のファイルサイズに multipart のヘッダー分を加える）
+const maxMediaRequestSize = model.MaxAudioSize + 1<<20
+
+type AdminMediaHandler struct {
+	mediaUseCase usecase.IMediaUseCase
+}
+
+func NewAdminMediaHandler(categoryRepo repository.ICategoryRepository, mediaStorage service.IMediaStorage, mediaSigner service.IMediaSigner) *AdminMediaHandler {
+	mediaUseCase := usecase.NewMediaUseCase(categoryRepo, mediaStorage, mediaSigner)
+	return &AdminMediaHandler{
+		mediaUseCase: mediaUseCase,
+	}
+}
+
+// UploadMedia multipart/form-data の file（ファイル）と category（カテゴリ ID）を受け取る
+func (h *AdminMediaHandler) UploadMedia(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxMediaRequestSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			HandleError(c, errs.NewBadRequestError("request body is too large"))
+			return
+		}
+		HandleError(c, errs.NewBadRequestError("file is required"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		HandleError(c, errs.NewInternalServerError(err))
+		return
+	}
+	defer file.Close()
+
+	req := &dto.MediaUploadRequest{
+		Category: c.PostForm("category"),
+		File:     file,
+		Size:     fileHeader.Size,
+	}
+
+	result, err := h.mediaUseCase.UploadMedia(c.Request.Context(), req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
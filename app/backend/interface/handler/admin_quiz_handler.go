@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminQuizHandler struct {
+	quizAdminUseCase usecase.IQuizAdminUseCase
+}
+
+func NewAdminQuizHandler(quizRepo repository.IQuizRepository, categoryRepo repository.ICategoryRepository) *AdminQuizHandler {
+	quizAdminUseCase := usecase.NewQuizAdminUseCase(quizRepo, categoryRepo)
+	return &AdminQuizHandler{
+		quizAdminUseCase: quizAdminUseCase,
+	}
+}
+
+func (h *AdminQuizHandler) CreateQuiz(c *gin.Context) {
+	var req dto.QuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	quiz, err := h.quizAdminUseCase.CreateQuiz(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, quiz)
+}
+
+func (h *AdminQuizHandler) UpdateQuiz(c *gin.Context) {
+	var req dto.QuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	quiz, err := h.quizAdminUseCase.UpdateQuiz(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quiz)
+}
+
+func (h *AdminQuizHandler) PatchQuiz(c *gin.Context) {
+	var req dto.PatchQuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("invalid request body"))
+		return
+	}
+
+	quiz, err := h.quizAdminUseCase.PatchQuiz(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, quiz)
+}
+
+func (h *AdminQuizHandler) DeleteQuiz(c *gin.Context) {
+	if err := h.quizAdminUseCase.DeleteQuiz(c.Request.Context(), c.Param("id")); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
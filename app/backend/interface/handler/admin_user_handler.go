@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminUserHandler struct {
+	userAdminUseCase usecase.IUserAdminUseCase
+}
+
+func NewAdminUserHandler(userRepo repository.IUserRepository) *AdminUserHandler {
+	userAdminUseCase := usecase.NewUserAdminUseCase(userRepo)
+	return &AdminUserHandler{
+		userAdminUseCase: userAdminUseCase,
+	}
+}
+
+func (h *AdminUserHandler) UpdateUserRole(c *gin.Context) {
+	var req dto.UpdateUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("role is required"))
+		return
+	}
+
+	user, err := h.userAdminUseCase.UpdateUserRole(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUserResponse(user))
+}
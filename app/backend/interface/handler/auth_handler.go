@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler struct {
+	authUseCase usecase.IAuthUseCase
+}
+
+func NewAuthHandler(userRepo repository.IUserRepository, tokenService service.ITokenService) *AuthHandler {
+	authUseCase := usecase.NewAuthUseCase(userRepo, tokenService)
+	return &AuthHandler{
+		authUseCase: authUseCase,
+	}
+}
+
+func (h *AuthHandler) SignUp(c *gin.Context) {
+	var req dto.SignUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("email and password are required"))
+		return
+	}
+
+	result, err := h.authUseCase.SignUp(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewAuthResponse(result))
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("email and password are required"))
+		return
+	}
+
+	result, err := h.authUseCase.Login(c.Request.Context(), &req)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewAuthResponse(result))
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("refreshToken is required"))
+		return
+	}
+
+	result, err := h.authUseCase.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewAuthResponse(result))
+}
+
+func (h *AuthHandler) GetMe(c *gin.Context) {
+	user, err := h.authUseCase.GetCurrentUser(c.Request.Context())
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewUserResponse(user))
+}
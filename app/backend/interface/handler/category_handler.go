@@ -2,9 +2,14 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/cursor"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
 	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,8 +18,8 @@ type CategoryHandler struct {
 	categoryUseCase usecase.ICategoryUseCase
 }
 
-func NewCategoryHandler(categoryRepo repository.ICategoryRepository) *CategoryHandler {
-	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo)
+func NewCategoryHandler(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository, cursorCodec *cursor.Codec, mediaSigner service.IMediaSigner) *CategoryHandler {
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, quizRepo, cursorCodec, mediaSigner)
 	return &CategoryHandler{
 		categoryUseCase: categoryUseCase,
 	}
@@ -29,3 +34,29 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 
 	c.JSON(http.StatusOK, categories)
 }
+
+func (h *CategoryHandler) GetCategoryQuizzes(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		HandleError(c, errs.NewBadRequestError("category id is required"))
+		return
+	}
+
+	limit := usecase.DefaultQuizPageSize
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			HandleError(c, errs.NewBadRequestError("limit must be a number"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	page, err := h.categoryUseCase.GetCategoryQuizzes(c.Request.Context(), id, c.Query("cursor"), limit)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewQuizPageResponse(page))
+}
@@ -4,13 +4,18 @@ import (
 	"net/http"
 
 	"audio-slide-app/common/errs"
+	"audio-slide-app/common/locale"
 	"audio-slide-app/domain/dto"
 
 	"github.com/gin-gonic/gin"
 )
 
 // HandleError は共通のエラーハンドラー関数です
+// message はリクエストの言語で返却する（details は開発者向けのため翻訳しない）
+// エラーはアクセスログに出力するため gin.Context にも記録する
 func HandleError(c *gin.Context, err error) {
+	_ = c.Error(err)
+	lang := locale.FromContext(c.Request.Context())
 	if appErr, ok := err.(*errs.AppError); ok {
 		statusCode := http.StatusInternalServerError
 		switch appErr.Code {
@@ -20,14 +25,28 @@ func HandleError(c *gin.Context, err error) {
 			statusCode = http.StatusNotFound
 		case errs.EC003:
 			statusCode = http.StatusInternalServerError
+		case errs.EC004:
+			statusCode = http.StatusUnauthorized
+		case errs.EC005:
+			statusCode = http.StatusForbidden
 		}
 
-		response := dto.NewErrorResponse(appErr.Code, appErr.Message, appErr.Details)
+		message := errs.LocalizedMessage(appErr.Code, lang)
+		if message == "" {
+			message = appErr.Message
+		}
+		response := dto.NewErrorResponse(appErr.Code, message, appErr.Details)
+		for _, fieldErr := range appErr.Fields {
+			response.Error.Fields = append(response.Error.Fields, dto.FieldErrorDetail{
+				Field:   fieldErr.Field,
+				Message: fieldErr.Message,
+			})
+		}
 		c.JSON(statusCode, response)
 		return
 	}
 
 	// 未知のエラー
-	response := dto.NewErrorResponse(errs.EC003, errs.EC003Message, err.Error())
+	response := dto.NewErrorResponse(errs.EC003, errs.LocalizedMessage(errs.EC003, lang), err.Error())
 	c.JSON(http.StatusInternalServerError, response)
 }
@@ -2,19 +2,36 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
+	"audio-slide-app/application/usecase"
 	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/service"
 
 	"github.com/gin-gonic/gin"
 )
 
-type HealthHandler struct{}
+type HealthHandler struct {
+	healthUseCase usecase.IHealthUseCase
+}
+
+func NewHealthHandler(checkers []service.IHealthChecker, timeout time.Duration) *HealthHandler {
+	return &HealthHandler{
+		healthUseCase: usecase.NewHealthUseCase(checkers, timeout),
+	}
+}
 
-func NewHealthHandler() *HealthHandler {
-	return &HealthHandler{}
+// GetLive プロセスが応答できれば常に 200 を返す
+func (h *HealthHandler) GetLive(c *gin.Context) {
+	c.JSON(http.StatusOK, h.healthUseCase.Live(c.Request.Context()))
 }
 
-func (h *HealthHandler) GetHealth(c *gin.Context) {
-	response := dto.NewHealthResponse()
+// GetReady 依存先をすべて利用できる場合は 200、そうでない場合は 503 を返す（ALB はこのタスクにリクエストを転送しなくなる）
+func (h *HealthHandler) GetReady(c *gin.Context) {
+	response := h.healthUseCase.Ready(c.Request.Context())
+	if response.Status != dto.HealthStatusOK {
+		c.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
 	c.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProgressHandler struct {
+	progressUseCase usecase.IProgressUseCase
+}
+
+func NewProgressHandler(progressRepo repository.IProgressRepository, categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) *ProgressHandler {
+	progressUseCase := usecase.NewProgressUseCase(progressRepo, categoryRepo, quizRepo)
+	return &ProgressHandler{
+		progressUseCase: progressUseCase,
+	}
+}
+
+func (h *ProgressHandler) GetProgress(c *gin.Context) {
+	progresses, err := h.progressUseCase.GetProgress(c.Request.Context())
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewProgressResponse(progresses))
+}
+
+func (h *ProgressHandler) GetCategoryProgress(c *gin.Context) {
+	detail, err := h.progressUseCase.GetCategoryProgress(c.Request.Context(), c.Param("category"))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewCategoryProgressDetailResponse(detail))
+}
@@ -7,17 +7,29 @@ import (
 
 	"audio-slide-app/application/usecase"
 	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
 	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
 
 	"github.com/gin-gonic/gin"
 )
 
+// GET /api/quiz の出題モード
+const (
+	// QuizModeRandom カテゴリからランダムに出題する（デフォルト）
+	QuizModeRandom = "random"
+	// QuizModeReview 復習の期日を迎えた問題を優先して出題する（ログインが必要）
+	QuizModeReview = "review"
+)
+
 type QuizHandler struct {
 	quizUseCase usecase.IQuizUseCase
 }
 
-func NewQuizHandler(quizRepo repository.IQuizRepository) *QuizHandler {
-	quizUseCase := usecase.NewQuizUseCase(quizRepo)
+func NewQuizHandler(quizRepo repository.IQuizRepository, categoryRepo repository.ICategoryRepository, progressRepo repository.IProgressRepository, mediaSigner service.IMediaSigner) *QuizHandler {
+	progressUseCase := usecase.NewProgressUseCase(progressRepo, categoryRepo, quizRepo)
+	quizUseCase := usecase.NewTracedQuizUseCase(usecase.NewQuizUseCase(quizRepo, categoryRepo, progressUseCase, mediaSigner))
 	return &QuizHandler{
 		quizUseCase: quizUseCase,
 	}
@@ -39,14 +51,27 @@ func (h *QuizHandler) GetQuizzes(c *gin.Context) {
 		}
 	}
 
-	quizzes, err := h.quizUseCase.GetQuizzesByCategory(c.Request.Context(), category, count)
+	choiceCount, err := parseChoiceCount(c)
 	if err != nil {
-		fmt.Println(fmt.Errorf("GetQuizzesByCategoryError : %w", err))
 		HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, quizzes)
+	var quizzes []*model.Quiz
+	switch mode := c.Query("mode"); mode {
+	case "", QuizModeRandom:
+		quizzes, err = h.quizUseCase.GetQuizzesByCategory(c.Request.Context(), category, count, choiceCount)
+	case QuizModeReview:
+		quizzes, err = h.quizUseCase.GetReviewQuizzes(c.Request.Context(), category, count, choiceCount)
+	default:
+		err = errs.NewBadRequestError(fmt.Sprintf("mode must be '%s' or '%s'", QuizModeRandom, QuizModeReview))
+	}
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewQuizResponses(quizzes))
 }
 
 func (h *QuizHandler) GetQuizByID(c *gin.Context) {
@@ -56,11 +81,52 @@ func (h *QuizHandler) GetQuizByID(c *gin.Context) {
 		return
 	}
 
-	quiz, err := h.quizUseCase.GetQuizByID(c.Request.Context(), id)
+	choiceCount, err := parseChoiceCount(c)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	quiz, err := h.quizUseCase.GetQuizByID(c.Request.Context(), id, choiceCount)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewQuizResponse(quiz))
+}
+
+func (h *QuizHandler) SubmitAnswer(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		HandleError(c, errs.NewBadRequestError("quiz id is required"))
+		return
+	}
+
+	var req dto.SubmitAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("answer is required"))
+		return
+	}
+
+	result, err := h.quizUseCase.SubmitAnswer(c.Request.Context(), id, req.Answer)
 	if err != nil {
 		HandleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, quiz)
+	c.JSON(http.StatusOK, result)
+}
+
+// parseChoiceCount choices クエリパラメータ（1問あたりの選択肢数）を取得する（未指定の場合は0）
+func parseChoiceCount(c *gin.Context) (int, error) {
+	value := c.Query("choices")
+	if value == "" {
+		return 0, nil
+	}
+	choiceCount, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errs.NewBadRequestError("choices must be an integer")
+	}
+	return choiceCount, nil
 }
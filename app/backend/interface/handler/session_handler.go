@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SessionHandler struct {
+	sessionUseCase usecase.ISessionUseCase
+}
+
+func NewSessionHandler(sessionRepo repository.ISessionRepository, quizRepo repository.IQuizRepository, categoryRepo repository.ICategoryRepository, progressRepo repository.IProgressRepository, mediaSigner service.IMediaSigner) *SessionHandler {
+	progressUseCase := usecase.NewProgressUseCase(progressRepo, categoryRepo, quizRepo)
+	quizUseCase := usecase.NewTracedQuizUseCase(usecase.NewQuizUseCase(quizRepo, categoryRepo, progressUseCase, mediaSigner))
+	sessionUseCase := usecase.NewSessionUseCase(sessionRepo, quizUseCase, progressUseCase)
+	return &SessionHandler{
+		sessionUseCase: sessionUseCase,
+	}
+}
+
+func (h *SessionHandler) CreateSession(c *gin.Context) {
+	var req dto.CreateSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("category is required"))
+		return
+	}
+
+	session, quizzes, err := h.sessionUseCase.CreateSession(c.Request.Context(), req.Category, req.Count, req.Choices)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.NewSessionResponse(session, quizzes))
+}
+
+func (h *SessionHandler) SubmitAnswer(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		HandleError(c, errs.NewBadRequestError("session id is required"))
+		return
+	}
+
+	var req dto.SubmitSessionAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, errs.NewBadRequestError("quizId and answer are required"))
+		return
+	}
+
+	result, err := h.sessionUseCase.SubmitSessionAnswer(c.Request.Context(), id, req.QuizID, req.Answer)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *SessionHandler) GetResult(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		HandleError(c, errs.NewBadRequestError("session id is required"))
+		return
+	}
+
+	result, err := h.sessionUseCase.GetSessionResult(c.Request.Context(), id)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,442 @@
+package job
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"audio-slide-app/common/locale"
+	"audio-slide-app/domain/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatCSV  = "csv"
+
+	// csvChoiceSeparator CSVで選択肢を1列に格納する際の区切り文字
+	csvChoiceSeparator = "|"
+	csvTypeCategory    = "category"
+	csvTypeQuiz        = "quiz"
+)
+
+// csvHeader CSVはカテゴリとクイズを type 列で区別して1ファイルに格納する
+// 翻訳は言語ごとに name_en のような列で表す
+var csvHeader = newCSVHeader()
+
+// csvOptionalColumns 後から追加した列（省略された古いファイルも読み込めるようにする）
+var csvOptionalColumns = newCSVOptionalColumns()
+
+// csvTranslatedColumns 翻訳を持てる列
+var csvTranslatedColumns = []string{"name", "description", "correctAnswer", "choices", "explanation"}
+
+func newCSVHeader() []string {
+	header := []string{
+		"type", "id", "name", "description", "thumbnail", "sortOrder",
+		"category", "questionImageUrl", "questionAudioUrl", "correctAnswer", "choices", "explanation", "generateDistractors",
+		"audioAssets",
+	}
+	for _, lang := range translationLanguages() {
+		for _, name := range csvTranslatedColumns {
+			header = append(header, csvTranslatedColumn(name, lang))
+		}
+	}
+	return header
+}
+
+func newCSVOptionalColumns() map[string]bool {
+	columns := map[string]bool{
+		"generateDistractors": true,
+		"audioAssets":         true,
+	}
+	for _, lang := range translationLanguages() {
+		for _, name := range csvTranslatedColumns {
+			columns[csvTranslatedColumn(name, lang)] = true
+		}
+	}
+	return columns
+}
+
+// csvTranslatedColumn 翻訳の列名（例: correctAnswer_en）
+func csvTranslatedColumn(name, lang string) string {
+	return name + "_" + lang
+}
+
+// translationLanguages 翻訳を持てる言語（基本の言語以外の対応言語）
+func translationLanguages() []string {
+	langs := make([]string, 0, len(locale.Supported))
+	for _, lang := range locale.Supported {
+		if lang != locale.Default {
+			langs = append(langs, lang)
+		}
+	}
+	return langs
+}
+
+// contentFile JSON/YAMLファイルの構造
+type contentFile struct {
+	Categories []categoryRecord `json:"categories" yaml:"categories"`
+	Quizzes    []quizRecord     `json:"quizzes" yaml:"quizzes"`
+}
+
+type categoryRecord struct {
+	ID          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Thumbnail   string `json:"thumbnail" yaml:"thumbnail"`
+	SortOrder   int    `json:"sortOrder" yaml:"sortOrder"`
+	// Translations 省略時は翻訳なし
+	Translations map[string]model.CategoryText `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+type quizRecord struct {
+	ID               string   `json:"id" yaml:"id"`
+	Category         string   `json:"category" yaml:"category"`
+	QuestionImageURL string   `json:"questionImageUrl" yaml:"questionImageUrl"`
+	QuestionAudioURL string   `json:"questionAudioUrl" yaml:"questionAudioUrl"`
+	CorrectAnswer    string   `json:"correctAnswer" yaml:"correctAnswer"`
+	Choices          []string `json:"choices" yaml:"choices"`
+	Explanation      string   `json:"explanation" yaml:"explanation"`
+	// GenerateDistractors 省略時は false（JSON/YAMLでは true の場合のみ出力する）
+	GenerateDistractors bool `json:"generateDistractors,omitempty" yaml:"generateDistractors,omitempty"`
+	// Translations 省略時は翻訳なし
+	Translations map[string]model.QuizText `json:"translations,omitempty" yaml:"translations,omitempty"`
+	// AudioAssets 省略時は音声の一覧なし（CSV では JSON の配列を1列に格納する）
+	AudioAssets []model.AudioAsset `json:"audioAssets,omitempty" yaml:"audioAssets,omitempty"`
+}
+
+// DetectFormat ファイルの拡張子からフォーマットを判定する
+func DetectFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".csv":
+		return FormatCSV, nil
+	}
+	return "", fmt.Errorf("cannot detect format from file name '%s'", path)
+}
+
+// DecodeContent 指定フォーマットの入力をコンテンツに変換する
+func DecodeContent(r io.Reader, format string) (*model.ContentSet, error) {
+	var file contentFile
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&file); err != nil {
+			return nil, fmt.Errorf("failed to decode json: %w", err)
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&file); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to decode yaml: %w", err)
+		}
+	case FormatCSV:
+		decoded, err := decodeCSV(r)
+		if err != nil {
+			return nil, err
+		}
+		file = *decoded
+	default:
+		return nil, fmt.Errorf("unsupported format '%s'", format)
+	}
+
+	return file.toContentSet(), nil
+}
+
+// EncodeContent コンテンツを指定フォーマットで出力する
+func EncodeContent(w io.Writer, content *model.ContentSet, format string) error {
+	file := newContentFile(content)
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(file)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(file); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case FormatCSV:
+		return encodeCSV(w, file)
+	}
+	return fmt.Errorf("unsupported format '%s'", format)
+}
+
+func newContentFile(content *model.ContentSet) *contentFile {
+	file := &contentFile{
+		Categories: make([]categoryRecord, 0, len(content.Categories)),
+		Quizzes:    make([]quizRecord, 0, len(content.Quizzes)),
+	}
+	for _, category := range content.Categories {
+		file.Categories = append(file.Categories, categoryRecord{
+			ID:           category.ID,
+			Name:         category.Name,
+			Description:  category.Description,
+			Thumbnail:    category.Thumbnail,
+			SortOrder:    category.SortOrder,
+			Translations: category.Translations,
+		})
+	}
+	for _, quiz := range content.Quizzes {
+		file.Quizzes = append(file.Quizzes, quizRecord{
+			ID:                  quiz.ID,
+			Category:            quiz.Category,
+			QuestionImageURL:    quiz.QuestionImageURL,
+			QuestionAudioURL:    quiz.QuestionAudioURL,
+			CorrectAnswer:       quiz.CorrectAnswer,
+			Choices:             quiz.Choices,
+			Explanation:         quiz.Explanation,
+			GenerateDistractors: quiz.GenerateDistractors,
+			Translations:        quiz.Translations,
+			AudioAssets:         quiz.AudioAssets,
+		})
+	}
+	return file
+}
+
+func (f *contentFile) toContentSet() *model.ContentSet {
+	content := &model.ContentSet{
+		Categories: make([]*model.Category, 0, len(f.Categories)),
+		Quizzes:    make([]*model.Quiz, 0, len(f.Quizzes)),
+	}
+	for _, record := range f.Categories {
+		category := model.NewCategory(record.ID, record.Name, record.Description, record.Thumbnail)
+		category.SortOrder = record.SortOrder
+		category.Translations = record.Translations
+		content.Categories = append(content.Categories, category)
+	}
+	for _, record := range f.Quizzes {
+		quiz := model.NewQuiz(
+			record.ID,
+			record.QuestionImageURL,
+			record.QuestionAudioURL,
+			record.CorrectAnswer,
+			record.Choices,
+			record.Category,
+			record.Explanation,
+		)
+		quiz.GenerateDistractors = record.GenerateDistractors
+		quiz.Translations = record.Translations
+		quiz.AudioAssets = record.AudioAssets
+		content.Quizzes = append(content.Quizzes, quiz)
+	}
+	return content
+}
+
+func decodeCSV(r io.Reader) (*contentFile, error) {
+	reader := csv.NewReader(r)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode csv: %w", err)
+	}
+	if len(rows) == 0 {
+		return &contentFile{}, nil
+	}
+
+	// ヘッダー行から列の位置を決める（列の順番は問わない）
+	// JSON/YAML と同様に未知の列は拒否する（列名の誤りや未対応の言語の翻訳を黙って読み捨てない）
+	known := make(map[string]bool, len(csvHeader))
+	for _, name := range csvHeader {
+		known[name] = true
+	}
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, fmt.Errorf("csv header has unknown column '%s'", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range csvHeader {
+		if _, ok := columns[name]; !ok && !csvOptionalColumns[name] {
+			return nil, fmt.Errorf("csv header is missing column '%s'", name)
+		}
+	}
+
+	file := &contentFile{}
+	for lineNo, row := range rows[1:] {
+		get := func(name string) string {
+			i, ok := columns[name]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		switch get("type") {
+		case csvTypeCategory:
+			sortOrder := 0
+			if value := get("sortOrder"); value != "" {
+				parsed, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("csv line %d: invalid sortOrder '%s'", lineNo+2, value)
+				}
+				sortOrder = parsed
+			}
+			var translations map[string]model.CategoryText
+			for _, lang := range translationLanguages() {
+				text := model.CategoryText{
+					Name:        get(csvTranslatedColumn("name", lang)),
+					Description: get(csvTranslatedColumn("description", lang)),
+				}
+				if text != (model.CategoryText{}) {
+					if translations == nil {
+						translations = make(map[string]model.CategoryText)
+					}
+					translations[lang] = text
+				}
+			}
+			file.Categories = append(file.Categories, categoryRecord{
+				ID:           get("id"),
+				Name:         get("name"),
+				Description:  get("description"),
+				Thumbnail:    get("thumbnail"),
+				SortOrder:    sortOrder,
+				Translations: translations,
+			})
+		case csvTypeQuiz:
+			var translations map[string]model.QuizText
+			for _, lang := range translationLanguages() {
+				text := model.QuizText{
+					CorrectAnswer: get(csvTranslatedColumn("correctAnswer", lang)),
+					Choices:       csvChoices(get(csvTranslatedColumn("choices", lang))),
+					Explanation:   get(csvTranslatedColumn("explanation", lang)),
+				}
+				if text.CorrectAnswer != "" || len(text.Choices) > 0 || text.Explanation != "" {
+					if translations == nil {
+						translations = make(map[string]model.QuizText)
+					}
+					translations[lang] = text
+				}
+			}
+			generateDistractors := false
+			if value := get("generateDistractors"); value != "" {
+				parsed, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("csv line %d: invalid generateDistractors '%s'", lineNo+2, value)
+				}
+				generateDistractors = parsed
+			}
+			var audioAssets []model.AudioAsset
+			if value := get("audioAssets"); value != "" {
+				if err := json.Unmarshal([]byte(value), &audioAssets); err != nil {
+					return nil, fmt.Errorf("csv line %d: invalid audioAssets: %w", lineNo+2, err)
+				}
+			}
+			file.Quizzes = append(file.Quizzes, quizRecord{
+				ID:                  get("id"),
+				Category:            get("category"),
+				QuestionImageURL:    get("questionImageUrl"),
+				QuestionAudioURL:    get("questionAudioUrl"),
+				CorrectAnswer:       get("correctAnswer"),
+				Choices:             csvChoices(get("choices")),
+				Explanation:         get("explanation"),
+				GenerateDistractors: generateDistractors,
+				Translations:        translations,
+				AudioAssets:         audioAssets,
+			})
+		default:
+			return nil, fmt.Errorf("csv line %d: unknown type '%s'", lineNo+2, get("type"))
+		}
+	}
+
+	return file, nil
+}
+
+func encodeCSV(w io.Writer, file *contentFile) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, category := range file.Categories {
+		values := map[string]string{
+			"type":        csvTypeCategory,
+			"id":          category.ID,
+			"name":        category.Name,
+			"description": category.Description,
+			"thumbnail":   category.Thumbnail,
+			"sortOrder":   strconv.Itoa(category.SortOrder),
+		}
+		for lang, text := range category.Translations {
+			values[csvTranslatedColumn("name", lang)] = text.Name
+			values[csvTranslatedColumn("description", lang)] = text.Description
+		}
+		if err := writer.Write(csvRow(values)); err != nil {
+			return err
+		}
+	}
+	for _, quiz := range file.Quizzes {
+		values := map[string]string{
+			"type":                csvTypeQuiz,
+			"id":                  quiz.ID,
+			"category":            quiz.Category,
+			"questionImageUrl":    quiz.QuestionImageURL,
+			"questionAudioUrl":    quiz.QuestionAudioURL,
+			"correctAnswer":       quiz.CorrectAnswer,
+			"choices":             strings.Join(quiz.Choices, csvChoiceSeparator),
+			"explanation":         quiz.Explanation,
+			"generateDistractors": csvBool(quiz.GenerateDistractors),
+		}
+		if len(quiz.AudioAssets) > 0 {
+			encoded, err := json.Marshal(quiz.AudioAssets)
+			if err != nil {
+				return err
+			}
+			values["audioAssets"] = string(encoded)
+		}
+		for lang, text := range quiz.Translations {
+			values[csvTranslatedColumn("correctAnswer", lang)] = text.CorrectAnswer
+			values[csvTranslatedColumn("choices", lang)] = strings.Join(text.Choices, csvChoiceSeparator)
+			values[csvTranslatedColumn("explanation", lang)] = text.Explanation
+		}
+		if err := writer.Write(csvRow(values)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvRow 列名ごとの値をヘッダーの順に並べる（値のない列は空欄）
+// 未対応の言語の翻訳はヘッダーに列がないため出力されない
+func csvRow(values map[string]string) []string {
+	row := make([]string, len(csvHeader))
+	for i, name := range csvHeader {
+		row[i] = values[name]
+	}
+	return row
+}
+
+// csvChoices 区切り文字で連結された選択肢を分割する（空欄の場合は nil）
+func csvChoices(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var choices []string
+	for _, choice := range strings.Split(value, csvChoiceSeparator) {
+		choices = append(choices, strings.TrimSpace(choice))
+	}
+	return choices
+}
+
+// csvBool 既定値（false）は空欄で出力する
+func csvBool(value bool) string {
+	if !value {
+		return ""
+	}
+	return strconv.FormatBool(value)
+}
@@ -0,0 +1,289 @@
+package job
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"audio-slide-app/domain/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestContent 省略可能な項目（翻訳・誤答の自動生成・音声の一覧）を持つクイズと持たないクイズを含むコンテンツ
+func newTestContent() *model.ContentSet {
+	flags := model.NewCategory("flags", "国旗", "世界の国旗", "images/thumbnails/flags.png")
+	flags.SortOrder = 1
+	flags.Translations = map[string]model.CategoryText{"en": {Name: "Flags", Description: "Flags of the world"}}
+	animals := model.NewCategory("animals", "動物", "", "")
+	animals.SortOrder = 2
+
+	italy := model.NewQuiz("quiz_flag_001", "images/flags/it.png", "audio/flags/it.mp3", "イタリア", []string{"イタリア", "フランス", "ドイツ"}, "flags", "緑・白・赤の三色旗")
+	italy.GenerateDistractors = true
+	italy.Translations = map[string]model.QuizText{"en": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France", "Germany"}, Explanation: "Green, white and red"}}
+	italy.AudioAssets = []model.AudioAsset{
+		{Kind: model.AudioKindReading, Language: "ja", URL: "audio/flags/it_ja.mp3", DurationMs: 1200, MimeType: "audio/mpeg"},
+	}
+	lion := model.NewQuiz("quiz_animal_001", "images/animals/lion.png", "", "ライオン", []string{"ライオン", "トラ"}, "animals", "")
+
+	return &model.ContentSet{
+		Categories: []*model.Category{flags, animals},
+		Quizzes:    []*model.Quiz{italy, lion},
+	}
+}
+
+// withoutTimestamps 読み込み時刻で設定される作成日時・更新日時を除いて比較する
+func withoutTimestamps(content *model.ContentSet) *model.ContentSet {
+	for _, quiz := range content.Quizzes {
+		quiz.CreatedAt = time.Time{}
+		quiz.UpdatedAt = time.Time{}
+	}
+	return content
+}
+
+func TestContentCodec_RoundTrip(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatYAML, FormatCSV} {
+		t.Run("正常系_"+format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, EncodeContent(&buf, newTestContent(), format))
+
+			decoded, err := DecodeContent(&buf, format)
+
+			assert.NoError(t, err)
+			assert.Equal(t, withoutTimestamps(newTestContent()), withoutTimestamps(decoded))
+		})
+	}
+}
+
+func TestDecodeContent_CSV(t *testing.T) {
+	const header = "type,id,name,description,thumbnail,sortOrder,category,questionImageUrl,questionAudioUrl,correctAnswer,choices,explanation"
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+		check   func(t *testing.T, content *model.ContentSet)
+	}{
+		{
+			name:  "正常系_省略可能な列なし",
+			input: header + "\nquiz,quiz_flag_001,,,,,flags,images/flags/it.png,,イタリア,イタリア | フランス,\n",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Len(t, content.Quizzes, 1)
+				quiz := content.Quizzes[0]
+				assert.Equal(t, []string{"イタリア", "フランス"}, quiz.Choices)
+				assert.False(t, quiz.GenerateDistractors)
+				assert.Nil(t, quiz.Translations)
+				assert.Nil(t, quiz.AudioAssets)
+			},
+		},
+		{
+			name:  "正常系_列の順番が異なる",
+			input: "id,type,name,category,questionImageUrl,questionAudioUrl,correctAnswer,choices,explanation,description,thumbnail,sortOrder\nflags,category,国旗,,,,,,,,,3\n",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Len(t, content.Categories, 1)
+				assert.Equal(t, "国旗", content.Categories[0].Name)
+				assert.Equal(t, 3, content.Categories[0].SortOrder)
+			},
+		},
+		{
+			name: "正常系_翻訳の列",
+			input: header + ",name_en,correctAnswer_en,choices_en\n" +
+				"category,flags,国旗,,,1,,,,,,,Flags,,\n" +
+				"quiz,quiz_flag_001,,,,,flags,images/flags/it.png,,イタリア,イタリア|フランス,,,Italy,Italy|France\n",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Equal(t, map[string]model.CategoryText{"en": {Name: "Flags"}}, content.Categories[0].Translations)
+				assert.Equal(t, map[string]model.QuizText{"en": {CorrectAnswer: "Italy", Choices: []string{"Italy", "France"}}}, content.Quizzes[0].Translations)
+			},
+		},
+		{
+			name:  "正常系_誤答の自動生成",
+			input: header + ",generateDistractors\nquiz,quiz_flag_001,,,,,flags,images/flags/it.png,,イタリア,イタリア|フランス,,true\n",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.True(t, content.Quizzes[0].GenerateDistractors)
+			},
+		},
+		{
+			name:  "正常系_空のファイル",
+			input: "",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Empty(t, content.Categories)
+				assert.Empty(t, content.Quizzes)
+			},
+		},
+		{
+			name:    "異常系_必須の列がない",
+			input:   strings.Replace(header, ",choices", "", 1) + "\n",
+			wantErr: "missing column 'choices'",
+		},
+		{
+			name:    "異常系_未知の列",
+			input:   header + ",explantion\n",
+			wantErr: "unknown column 'explantion'",
+		},
+		{
+			name:    "異常系_未対応の言語の翻訳の列",
+			input:   header + ",name_fr\n",
+			wantErr: "unknown column 'name_fr'",
+		},
+		{
+			name:    "異常系_不正な真偽値",
+			input:   header + ",generateDistractors\nquiz,quiz_flag_001,,,,,flags,images/flags/it.png,,イタリア,イタリア|フランス,,yes\n",
+			wantErr: "csv line 2: invalid generateDistractors 'yes'",
+		},
+		{
+			name:    "異常系_不正な数値",
+			input:   header + "\ncategory,flags,国旗,,,first,,,,,,\n",
+			wantErr: "csv line 2: invalid sortOrder 'first'",
+		},
+		{
+			name:    "異常系_不正な音声の一覧",
+			input:   header + ",audioAssets\nquiz,quiz_flag_001,,,,,flags,images/flags/it.png,,イタリア,イタリア|フランス,,\"{\"\"kind\"\":1}\"\n",
+			wantErr: "csv line 2: invalid audioAssets",
+		},
+		{
+			name:    "異常系_未知の行の種類",
+			input:   header + "\nanswer,quiz_flag_001,,,,,,,,,,\n",
+			wantErr: "csv line 2: unknown type 'answer'",
+		},
+		{
+			name:    "異常系_列数が合わない",
+			input:   header + "\nquiz,quiz_flag_001\n",
+			wantErr: "failed to decode csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := DecodeContent(strings.NewReader(tt.input), FormatCSV)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, content)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, content)
+		})
+	}
+}
+
+func TestDecodeContent_JSONAndYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		wantErr string
+		check   func(t *testing.T, content *model.ContentSet)
+	}{
+		{
+			name:   "正常系_JSONの省略可能な項目なし",
+			format: FormatJSON,
+			input:  `{"quizzes":[{"id":"quiz_flag_001","category":"flags","questionImageUrl":"images/flags/it.png","questionAudioUrl":"","correctAnswer":"イタリア","choices":["イタリア","フランス"],"explanation":""}]}`,
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Len(t, content.Quizzes, 1)
+				assert.False(t, content.Quizzes[0].GenerateDistractors)
+				assert.Nil(t, content.Quizzes[0].Translations)
+			},
+		},
+		{
+			name:   "正常系_YAMLの翻訳",
+			format: FormatYAML,
+			input:  "categories:\n  - id: flags\n    name: 国旗\n    translations:\n      en:\n        name: Flags\n",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Equal(t, "Flags", content.Categories[0].Translations["en"].Name)
+			},
+		},
+		{
+			name:   "正常系_空のYAML",
+			format: FormatYAML,
+			input:  "",
+			check: func(t *testing.T, content *model.ContentSet) {
+				assert.Empty(t, content.Quizzes)
+			},
+		},
+		{
+			name:    "異常系_JSONの未知の項目",
+			format:  FormatJSON,
+			input:   `{"quizzes":[{"id":"quiz_flag_001","answer":"イタリア"}]}`,
+			wantErr: "unknown field",
+		},
+		{
+			name:    "異常系_YAMLの未知の項目",
+			format:  FormatYAML,
+			input:   "quizzes:\n  - id: quiz_flag_001\n    answer: イタリア\n",
+			wantErr: "field answer not found",
+		},
+		{
+			name:    "異常系_JSONの不正な真偽値",
+			format:  FormatJSON,
+			input:   `{"quizzes":[{"id":"quiz_flag_001","generateDistractors":"yes"}]}`,
+			wantErr: "failed to decode json",
+		},
+		{
+			name:    "異常系_YAMLの不正な数値",
+			format:  FormatYAML,
+			input:   "categories:\n  - id: flags\n    sortOrder: first\n",
+			wantErr: "failed to decode yaml",
+		},
+		{
+			name:    "異常系_未対応のフォーマット",
+			format:  "xml",
+			input:   "<quizzes/>",
+			wantErr: "unsupported format 'xml'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := DecodeContent(strings.NewReader(tt.input), tt.format)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, content)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, content)
+		})
+	}
+}
+
+func TestEncodeContent_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeContent(&buf, newTestContent(), FormatCSV))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.Equal(t, strings.Join(csvHeader, ","), lines[0])
+	// 既定値の generateDistractors と翻訳のない列は空欄で出力する
+	assert.Equal(t, "quiz,quiz_animal_001,,,,,animals,images/animals/lion.png,,ライオン,ライオン|トラ,,,,,,,,", lines[4])
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "正常系_JSON", path: "content.json", want: FormatJSON},
+		{name: "正常系_YAML", path: "content.YML", want: FormatYAML},
+		{name: "正常系_CSV", path: "dir/content.csv", want: FormatCSV},
+		{name: "異常系_拡張子なし", path: "content", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectFormat(tt.path)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
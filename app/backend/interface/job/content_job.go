@@ -0,0 +1,105 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+)
+
+type ContentJob struct {
+	contentUseCase usecase.IContentUseCase
+}
+
+func NewContentJob(categoryRepo repository.ICategoryRepository, quizRepo repository.IQuizRepository) *ContentJob {
+	contentUseCase := usecase.NewContentUseCase(categoryRepo, quizRepo)
+	return &ContentJob{
+		contentUseCase: contentUseCase,
+	}
+}
+
+// Import ファイルの内容をテーブルに取り込み、結果を out に出力する
+func (j *ContentJob) Import(ctx context.Context, r io.Reader, format string, opts dto.ImportOptions, out io.Writer) error {
+	content, err := DecodeContent(r, format)
+	if err != nil {
+		return err
+	}
+
+	report, err := j.contentUseCase.Import(ctx, content, opts)
+	if err != nil {
+		return err
+	}
+
+	printImportReport(out, report)
+	return nil
+}
+
+// Export テーブルの内容を指定フォーマットで出力する
+func (j *ContentJob) Export(ctx context.Context, w io.Writer, format string) error {
+	content, err := j.contentUseCase.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	return EncodeContent(w, content, format)
+}
+
+// Lint テーブル上のクイズをチェックし、問題のあるアイテムを out に出力する
+// 問題が見つかった件数を返す
+func (j *ContentJob) Lint(ctx context.Context, out io.Writer) (int, error) {
+	report, err := j.contentUseCase.Lint(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, issue := range report.Issues {
+		fmt.Fprintf(out, "%s (category=%s)\n", issue.QuizID, issue.Category)
+		for _, fieldErr := range issue.Errors {
+			fmt.Fprintf(out, "  %s: %s\n", fieldErr.Field, fieldErr.Message)
+		}
+	}
+	fmt.Fprintf(out, "checked=%d invalid=%d\n", report.Checked, len(report.Issues))
+
+	return len(report.Issues), nil
+}
+
+// Reindex ランダム出題の対象になっていないクイズを再保存する
+func (j *ContentJob) Reindex(ctx context.Context, out io.Writer) error {
+	count, err := j.contentUseCase.Reindex(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "reindexed=%d\n", count)
+	return nil
+}
+
+func printImportReport(out io.Writer, report *model.ImportReport) {
+	if report.DryRun {
+		fmt.Fprintln(out, "[dry-run] no changes were written")
+	}
+	printImportResult(out, "categories", report.Categories)
+	printImportResult(out, "quizzes", report.Quizzes)
+}
+
+func printImportResult(out io.Writer, kind string, result model.ImportResult) {
+	fmt.Fprintf(out, "%s: created=%d updated=%d unchanged=%d skipped=%d deleted=%d\n",
+		kind, len(result.Created), len(result.Updated), len(result.Unchanged), len(result.Skipped), len(result.Deleted))
+	for _, entry := range []struct {
+		label string
+		ids   []string
+	}{
+		{"+", result.Created},
+		{"~", result.Updated},
+		{"=", result.Skipped},
+		{"-", result.Deleted},
+	} {
+		for _, id := range entry.ids {
+			fmt.Fprintf(out, "  %s %s\n", entry.label, id)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_usecase "audio-slide-app/mocks/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentJob_Import(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		format    string
+		opts      dto.ImportOptions
+		setupMock func(m *mock_usecase.MockIContentUseCase)
+		want      string
+		wantErr   string
+	}{
+		{
+			name:   "正常系_取り込み結果を出力",
+			input:  `{"categories":[{"id":"flags","name":"国旗"}]}`,
+			format: FormatJSON,
+			opts:   dto.ImportOptions{Upsert: true},
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Import(gomock.Any(), gomock.Any(), dto.ImportOptions{Upsert: true}).
+					DoAndReturn(func(_ context.Context, content *model.ContentSet, _ dto.ImportOptions) (*model.ImportReport, error) {
+						assert.Equal(t, "flags", content.Categories[0].ID)
+						return &model.ImportReport{
+							Categories: model.ImportResult{Updated: []string{"flags"}},
+							Quizzes:    model.ImportResult{Created: []string{"quiz_flag_001"}, Deleted: []string{"quiz_flag_002"}},
+						}, nil
+					})
+			},
+			want: "categories: created=0 updated=1 unchanged=0 skipped=0 deleted=0\n" +
+				"  ~ flags\n" +
+				"quizzes: created=1 updated=0 unchanged=0 skipped=0 deleted=1\n" +
+				"  + quiz_flag_001\n" +
+				"  - quiz_flag_002\n",
+		},
+		{
+			name:   "正常系_ドライラン",
+			input:  "",
+			format: FormatYAML,
+			opts:   dto.ImportOptions{DryRun: true},
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Import(gomock.Any(), gomock.Any(), dto.ImportOptions{DryRun: true}).
+					Return(&model.ImportReport{DryRun: true}, nil)
+			},
+			want: "[dry-run] no changes were written\n" +
+				"categories: created=0 updated=0 unchanged=0 skipped=0 deleted=0\n" +
+				"quizzes: created=0 updated=0 unchanged=0 skipped=0 deleted=0\n",
+		},
+		{
+			name:      "異常系_ファイルの読み込みエラーは取り込まない",
+			input:     "type,id\n",
+			format:    FormatCSV,
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {},
+			wantErr:   "csv header is missing column",
+		},
+		{
+			name:   "異常系_取り込みエラー",
+			input:  `{}`,
+			format: FormatJSON,
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("import failed"))
+			},
+			wantErr: "import failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockContentUseCase := mock_usecase.NewMockIContentUseCase(ctrl)
+			tt.setupMock(mockContentUseCase)
+			job := &ContentJob{contentUseCase: mockContentUseCase}
+
+			var out bytes.Buffer
+			err := job.Import(context.Background(), strings.NewReader(tt.input), tt.format, tt.opts, &out)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Empty(t, out.String())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestContentJob_Export(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		setupMock func(m *mock_usecase.MockIContentUseCase)
+		wantErr   string
+	}{
+		{
+			name:   "正常系_出力した内容を取り込める",
+			format: FormatCSV,
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Export(gomock.Any()).Return(newTestContent(), nil)
+			},
+		},
+		{
+			name:   "異常系_未対応のフォーマット",
+			format: "xml",
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Export(gomock.Any()).Return(newTestContent(), nil)
+			},
+			wantErr: "unsupported format 'xml'",
+		},
+		{
+			name:   "異常系_テーブルの読み込みエラー",
+			format: FormatJSON,
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Export(gomock.Any()).Return(nil, errors.New("scan failed"))
+			},
+			wantErr: "scan failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockContentUseCase := mock_usecase.NewMockIContentUseCase(ctrl)
+			tt.setupMock(mockContentUseCase)
+			job := &ContentJob{contentUseCase: mockContentUseCase}
+
+			var out bytes.Buffer
+			err := job.Export(context.Background(), &out, tt.format)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			decoded, err := DecodeContent(&out, tt.format)
+			assert.NoError(t, err)
+			assert.Equal(t, withoutTimestamps(newTestContent()), withoutTimestamps(decoded))
+		})
+	}
+}
+
+func TestContentJob_Lint(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(m *mock_usecase.MockIContentUseCase)
+		want      string
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "正常系_問題なし",
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Lint(gomock.Any()).Return(&model.LintReport{Checked: 3}, nil)
+			},
+			want: "checked=3 invalid=0\n",
+		},
+		{
+			name: "正常系_問題のあるクイズを出力",
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Lint(gomock.Any()).Return(&model.LintReport{
+					Checked: 3,
+					Issues: []model.LintIssue{{
+						QuizID:   "quiz_flag_001",
+						Category: "flags",
+						Errors:   model.FieldErrors{{Field: "choices", Message: "must contain correctAnswer"}},
+					}},
+				}, nil)
+			},
+			want:      "quiz_flag_001 (category=flags)\n  choices: must contain correctAnswer\nchecked=3 invalid=1\n",
+			wantCount: 1,
+		},
+		{
+			name: "異常系_テーブルの読み込みエラー",
+			setupMock: func(m *mock_usecase.MockIContentUseCase) {
+				m.EXPECT().Lint(gomock.Any()).Return(nil, errors.New("scan failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockContentUseCase := mock_usecase.NewMockIContentUseCase(ctrl)
+			tt.setupMock(mockContentUseCase)
+			job := &ContentJob{contentUseCase: mockContentUseCase}
+
+			var out bytes.Buffer
+			count, err := job.Lint(context.Background(), &out)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCount, count)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
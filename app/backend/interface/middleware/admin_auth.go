@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/interface/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAPIKeyHeader 管理APIの認証に使用するヘッダー
+const AdminAPIKeyHeader = "X-Admin-Key"
+
+// AdminAPIKeyUserID 管理APIキーで認証されたリクエストのユーザーID
+const AdminAPIKeyUserID = "admin-api-key"
+
+// AdminAuth 管理APIキーで認証するミドルウェア
+// キーが一致した場合は管理者としてユーザーを context に格納し、ヘッダーがない場合はユーザー認証（RequireRole）に委ねる
+// apiKey が未設定の場合、ヘッダー付きのリクエストは全て拒否する
+func AdminAuth(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(AdminAPIKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+			handler.HandleError(c, errs.NewUnauthorizedError("valid admin api key is required"))
+			c.Abort()
+			return
+		}
+
+		principal := &authctx.Principal{
+			UserID: AdminAPIKeyUserID,
+			Role:   string(model.RoleAdmin),
+		}
+		c.Request = c.Request.WithContext(authctx.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"audio-slide-app/application/usecase"
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/model"
+	"audio-slide-app/domain/repository"
+	"audio-slide-app/domain/service"
+	"audio-slide-app/interface/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+const bearerPrefix = "Bearer "
+
+// authErrorKey 検証に失敗したトークンのエラーを gin.Context に保持するキー（RequireUser / RequireRole が返す）
+const authErrorKey = "authError"
+
+// Authenticate Authorization ヘッダーのアクセストークンを検証し、ユーザーを context に格納するミドルウェア
+// ヘッダーがない場合や、不正・期限切れのトークンの場合は匿名アクセスとしてそのまま通す
+// （ログアウト後に古いトークンが残っていても匿名で使える画面を使えるようにする）
+// 認証が必要なエンドポイントでは RequireUser / RequireRole がトークンのエラーを EC004 で返す
+func Authenticate(userRepo repository.IUserRepository, tokenService service.ITokenService) gin.HandlerFunc {
+	authUseCase := usecase.NewAuthUseCase(userRepo, tokenService)
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		if !strings.HasPrefix(header, bearerPrefix) {
+			c.Set(authErrorKey, errs.NewUnauthorizedError("authorization header must be a bearer token"))
+			c.Next()
+			return
+		}
+
+		principal, err := authUseCase.Authenticate(c.Request.Context(), strings.TrimPrefix(header, bearerPrefix))
+		if err != nil {
+			c.Set(authErrorKey, err)
+			c.Next()
+			return
+		}
+
+		c.Request = c.Request.WithContext(authctx.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
+
+// RequireUser 認証済みのリクエストのみを通すミドルウェア（Authenticate の後に使用する）
+func RequireUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := authctx.PrincipalFromContext(c.Request.Context()); !ok {
+			handler.HandleError(c, authenticationError(c))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRole 指定した役割のユーザーのみを通すミドルウェア
+// 未認証の場合は EC004、役割が一致しない場合は EC005 で拒否する
+func RequireRole(roles ...model.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := authctx.PrincipalFromContext(c.Request.Context())
+		if !ok {
+			handler.HandleError(c, authenticationError(c))
+			c.Abort()
+			return
+		}
+
+		for _, role := range roles {
+			if model.Role(principal.Role) == role {
+				c.Next()
+				return
+			}
+		}
+
+		handler.HandleError(c, errs.NewForbiddenError(fmt.Sprintf("role '%s' is not allowed to access this resource", principal.Role)))
+		c.Abort()
+	}
+}
+
+// authenticationError 未認証のリクエストに返すエラー（トークンの検証に失敗していればその理由を返す）
+func authenticationError(c *gin.Context) error {
+	if value, ok := c.Get(authErrorKey); ok {
+		if err, ok := value.(error); ok {
+			return err
+		}
+	}
+	return errs.NewUnauthorizedError("authentication is required")
+}
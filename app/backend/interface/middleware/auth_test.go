@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/domain/dto"
+	"audio-slide-app/domain/model"
+	mock_repository "audio-slide-app/mocks/repository"
+	mock_service "audio-slide-app/mocks/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTokenService := mock_service.NewMockITokenService(ctrl)
+	mockTokenService.EXPECT().
+		ParseToken("valid-token", model.TokenTypeAccess).
+		Return(&model.TokenClaims{UserID: "user_001", Role: model.RoleLearner, Type: model.TokenTypeAccess}, nil).
+		AnyTimes()
+	mockTokenService.EXPECT().
+		ParseToken("stale-token", model.TokenTypeAccess).
+		Return(nil, errs.NewUnauthorizedError("invalid or expired token")).
+		AnyTimes()
+
+	r := gin.New()
+	api := r.Group("/api", Authenticate(mock_repository.NewMockIUserRepository(ctrl), mockTokenService))
+	// 匿名でも利用できるエンドポイント（ユーザーIDを返す）
+	api.GET("/quiz/:id", func(c *gin.Context) {
+		userID := ""
+		if principal, ok := authctx.PrincipalFromContext(c.Request.Context()); ok {
+			userID = principal.UserID
+		}
+		c.JSON(http.StatusOK, gin.H{"userId": userID})
+	})
+	api.GET("/me", RequireUser(), func(c *gin.Context) { c.Status(http.StatusOK) })
+	api.GET("/admin/quizzes", RequireRole(model.RoleAdmin), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantStatus    int
+		wantUserID    string
+		wantDetails   string
+	}{
+		{
+			name:          "正常系_有効なトークン",
+			path:          "/api/quiz/quiz_flag_001",
+			authorization: "Bearer valid-token",
+			wantStatus:    http.StatusOK,
+			wantUserID:    "user_001",
+		},
+		{
+			name:       "正常系_トークンなしは匿名",
+			path:       "/api/quiz/quiz_flag_001",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "正常系_期限切れのトークンは匿名",
+			path:          "/api/quiz/quiz_flag_001",
+			authorization: "Bearer stale-token",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "正常系_Bearer以外の形式は匿名",
+			path:          "/api/quiz/quiz_flag_001",
+			authorization: "Basic dXNlcjpwYXNz",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "正常系_ログインが必要なエンドポイント",
+			path:          "/api/me",
+			authorization: "Bearer valid-token",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:        "異常系_ログインが必要なエンドポイントにトークンなし",
+			path:        "/api/me",
+			wantStatus:  http.StatusUnauthorized,
+			wantDetails: "authentication is required",
+		},
+		{
+			name:          "異常系_ログインが必要なエンドポイントに期限切れのトークン",
+			path:          "/api/me",
+			authorization: "Bearer stale-token",
+			wantStatus:    http.StatusUnauthorized,
+			wantDetails:   "invalid or expired token",
+		},
+		{
+			name:          "異常系_役割が必要なエンドポイントに期限切れのトークン",
+			path:          "/api/admin/quizzes",
+			authorization: "Bearer stale-token",
+			wantStatus:    http.StatusUnauthorized,
+			wantDetails:   "invalid or expired token",
+		},
+		{
+			name:          "異常系_役割が不足している",
+			path:          "/api/admin/quizzes",
+			authorization: "Bearer valid-token",
+			wantStatus:    http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				var response dto.ErrorResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				if tt.wantDetails != "" {
+					assert.Equal(t, tt.wantDetails, response.Error.Details)
+				}
+				return
+			}
+			if tt.path == "/api/quiz/quiz_flag_001" {
+				var body map[string]string
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Equal(t, tt.wantUserID, body["userId"])
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"audio-slide-app/common/locale"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LanguageQueryParam 言語を明示的に指定するクエリパラメータ（Accept-Language より優先する）
+const LanguageQueryParam = "lang"
+
+// Locale リクエストの言語を決めて context に格納するミドルウェア
+// 対応していない言語が指定された場合は日本語になる
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := locale.Negotiate(c.Query(LanguageQueryParam), c.GetHeader("Accept-Language"))
+		c.Request = c.Request.WithContext(locale.WithLanguage(c.Request.Context(), lang))
+
+		// 言語によってレスポンスが変わるため、キャッシュが言語ごとに区別されるようにする
+		c.Header("Content-Language", lang)
+		c.Header("Vary", "Accept-Language")
+		c.Next()
+	}
+}
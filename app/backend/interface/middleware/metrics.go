@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/infrastructure/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics ルートごとの処理時間とエラーコードごとの件数を記録するミドルウェア
+// panic も 500 として記録するため、Recovery より前に使用する
+func Metrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		m.ObserveHTTPRequest(c.Request.Method, routePattern(c), c.Writer.Status(), time.Since(start))
+
+		// HandleError がレスポンスを返したエラー（gin.Context に記録される）
+		if err := c.Errors.Last(); err != nil {
+			code := errs.EC003
+			var appErr *errs.AppError
+			if errors.As(err.Err, &appErr) {
+				code = appErr.Code
+			}
+			m.IncAppError(code)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/infrastructure/metrics"
+	"audio-slide-app/interface/handler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := metrics.New()
+
+	r := gin.New()
+	r.Use(Metrics(m))
+	r.GET("/api/quiz/:id", func(c *gin.Context) {
+		handler.HandleError(c, errs.NewNotFoundError("quiz not found"))
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/quiz/missing", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unknown/path", nil))
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	assert.Contains(t, body, `audio_slide_http_request_duration_seconds_count{method="GET",route="/api/quiz/:id",status="404"} 1`)
+	// ルートに一致しないパスは固定のラベルにまとめる
+	assert.Contains(t, body, `audio_slide_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`)
+	assert.NotContains(t, body, `route="/unknown/path"`)
+	assert.Contains(t, body, `audio_slide_app_errors_total{code="EC002"} 1`)
+}
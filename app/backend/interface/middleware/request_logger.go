@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"audio-slide-app/common/authctx"
+	"audio-slide-app/common/errs"
+	"audio-slide-app/common/idgen"
+	"audio-slide-app/common/logging"
+	"audio-slide-app/interface/handler"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader リクエストID のヘッダー（ロードバランサーなどが付与した値があれば引き継ぐ）
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern 引き継ぐリクエストID の形式（ログを汚さないよう、それ以外は新しく発行する）
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// RequestLogger リクエストID・メソッド・ルートを付与したロガーを context に格納し、
+// レスポンス後にステータスと処理時間を1行のアクセスログとして出力するミドルウェア
+// ユースケースやリポジトリのログも同じリクエストID で検索できる
+func RequestLogger(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = idgen.New()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		requestLogger := logger.With(
+			"requestId", requestID,
+			"method", c.Request.Method,
+			"route", c.FullPath(),
+		)
+		// Tracing ミドルウェアのスパンがあれば、ログからトレースを検索できるようにする
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.IsValid() {
+			requestLogger = requestLogger.With("traceId", spanContext.TraceID().String())
+		}
+		c.Request = c.Request.WithContext(logging.WithLogger(c.Request.Context(), requestLogger))
+
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []any{
+			"status", status,
+			"latencyMs", float64(time.Since(start).Microseconds()) / 1000,
+			"path", c.Request.URL.Path,
+			"clientIp", c.ClientIP(),
+			"bytes", max(c.Writer.Size(), 0),
+		}
+		if principal, ok := authctx.PrincipalFromContext(c.Request.Context()); ok {
+			attrs = append(attrs, "userId", principal.UserID)
+		}
+		if err := c.Errors.Last(); err != nil {
+			attrs = append(attrs, "error", err.Error())
+			var appErr *errs.AppError
+			if errors.As(err.Err, &appErr) {
+				attrs = append(attrs, "errorCode", appErr.Code, "errorDetails", appErr.Details)
+			}
+		}
+
+		requestLogger.Log(c.Request.Context(), levelForStatus(status), "request completed", attrs...)
+	}
+}
+
+// Recovery ハンドラーの panic をログに出力し、EC003 のレスポンスを返すミドルウェア（RequestLogger の後に使用する）
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				logging.FromContext(c.Request.Context()).Error("panic recovered",
+					"panic", fmt.Sprint(recovered),
+					"stack", string(debug.Stack()),
+				)
+				handler.HandleError(c, errs.NewInternalServerError(errors.New("unexpected error")))
+				c.Abort()
+			}
+		}()
+		c.Next()
+	}
+}
+
+func levelForStatus(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	}
+	return slog.LevelInfo
+}
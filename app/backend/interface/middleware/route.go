@@ -0,0 +1,14 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// unmatchedRoute ルートに一致しなかったリクエストのラベル（パスをそのままラベルにすると系列が増え続けるため）
+const unmatchedRoute = "unmatched"
+
+// routePattern メトリクス・トレースに使う Gin のルートのパターン（例: /api/quiz/:id）
+func routePattern(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return unmatchedRoute
+}
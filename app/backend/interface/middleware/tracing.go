@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/infrastructure/tracing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing リクエストごとにサーバースパンを作成し、context に格納するミドルウェア
+// traceparent ヘッダーがあれば呼び出し元のトレースを引き継ぐ（RequestLogger より前に使用する）
+func Tracing(tp trace.TracerProvider) gin.HandlerFunc {
+	tracer := tp.Tracer(tracing.InstrumentationName)
+	return func(c *gin.Context) {
+		route := routePattern(c)
+
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := tracer.Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+			),
+		)
+		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if err := c.Errors.Last(); err != nil {
+			var appErr *errs.AppError
+			if errors.As(err.Err, &appErr) {
+				span.SetAttributes(attribute.String("app.error_code", appErr.Code))
+			}
+		}
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"audio-slide-app/common/errs"
+	"audio-slide-app/interface/handler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	r := gin.New()
+	r.Use(Tracing(provider))
+	r.GET("/api/quiz/:id", func(c *gin.Context) {
+		// ハンドラーの context にスパンが格納されている
+		if !trace.SpanContextFromContext(c.Request.Context()).IsValid() {
+			t.Error("span is not in the request context")
+		}
+		switch c.Param("id") {
+		case "missing":
+			handler.HandleError(c, errs.NewNotFoundError("quiz not found"))
+		case "broken":
+			handler.HandleError(c, errs.NewInternalServerError(nil))
+		default:
+			c.Status(http.StatusOK)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		path        string
+		traceparent string
+		wantStatus  int
+		wantCode    codes.Code
+	}{
+		{
+			name:        "正常系_呼び出し元のトレースを引き継ぐ",
+			path:        "/api/quiz/quiz_flag_001",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantStatus:  http.StatusOK,
+			wantCode:    codes.Unset,
+		},
+		{
+			name:       "異常系_見つからない",
+			path:       "/api/quiz/missing",
+			wantStatus: http.StatusNotFound,
+			wantCode:   codes.Unset,
+		},
+		{
+			name:       "異常系_内部エラー",
+			path:       "/api/quiz/broken",
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := exporter.GetSpans()
+			if !assert.Len(t, spans, 1) {
+				return
+			}
+			span := spans[0]
+			assert.Equal(t, "GET /api/quiz/:id", span.Name)
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+			assert.Contains(t, span.Attributes, semconv.HTTPRoute("/api/quiz/:id"))
+			assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", tt.wantStatus))
+			assert.Equal(t, tt.wantCode, span.Status.Code)
+			if tt.traceparent != "" {
+				assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+				assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+			}
+		})
+	}
+}
+
+func TestTracing_UnmatchedRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	r := gin.New()
+	r.Use(Tracing(provider))
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/unknown/path", nil))
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 1) {
+		return
+	}
+	// ルートに一致しないパスはスパン名にパスを含めない
+	assert.Equal(t, "GET unmatched", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, semconv.HTTPRoute("unmatched"))
+	assert.Contains(t, spans[0].Attributes, semconv.URLPath("/unknown/path"))
+}
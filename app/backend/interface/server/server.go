@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"audio-slide-app/common/logging"
+)
+
+// Options http.Server のタイムアウト・ヘッダーの上限と、停止時の動作
+type Options struct {
+	// ReadHeaderTimeout リクエストヘッダーの読み取りの上限（ヘッダーを少しずつ送り続ける接続を切る）
+	ReadHeaderTimeout time.Duration
+	// ReadTimeout リクエストボディを含む読み取りの上限
+	ReadTimeout time.Duration
+	// WriteTimeout レスポンスの書き込みの上限
+	WriteTimeout time.Duration
+	// IdleTimeout キープアライブの接続を待つ上限
+	IdleTimeout time.Duration
+	// MaxHeaderBytes リクエストヘッダーの最大サイズ
+	MaxHeaderBytes int
+	// DrainDelay 停止を始めてから新しい接続の受け付けを止めるまでの時間
+	// ALB からの登録解除は SIGTERM の前に ECS が済ませているため、ALB のノードへの反映の遅れで届くリクエストを受ける程度の短い時間でよい
+	DrainDelay time.Duration
+	// ShutdownTimeout 処理中のリクエストの完了を待つ上限
+	ShutdownTimeout time.Duration
+	// OnDrain 停止を始めたときに呼ぶ（/api/health/ready を失敗にする。ECS 以外から停止された場合の備え）
+	OnDrain func()
+	// ForceStop 停止中に閉じると、DrainDelay と処理中のリクエストの待機を打ち切って終了する（もう一度 SIGTERM を受け取った場合など）
+	ForceStop <-chan struct{}
+}
+
+// Server ルーターを http.Server で公開し、停止時は処理中のリクエストを待ってから終了する
+type Server struct {
+	httpServer *http.Server
+	opts       Options
+}
+
+func New(handler http.Handler, opts Options) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: opts.ReadHeaderTimeout,
+			ReadTimeout:       opts.ReadTimeout,
+			WriteTimeout:      opts.WriteTimeout,
+			IdleTimeout:       opts.IdleTimeout,
+			MaxHeaderBytes:    opts.MaxHeaderBytes,
+		},
+		opts: opts,
+	}
+}
+
+// Serve ctx がキャンセルされる（SIGTERM を受け取る）までリクエストを処理する
+// キャンセル後は OnDrain を呼んで DrainDelay だけ待ち、新しい接続の受け付けを止めてから
+// 処理中のリクエストの完了を ShutdownTimeout まで待つ。待ちきれなかった接続は切断する
+// ForceStop が閉じられた場合はどちらの待機も打ち切り、残っている接続を切断する
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Info("shutdown started", "drainDelay", s.opts.DrainDelay.String(), "shutdownTimeout", s.opts.ShutdownTimeout.String())
+	if s.opts.OnDrain != nil {
+		s.opts.OnDrain()
+	}
+	// 停止までの間に届いたリクエストは処理するが、接続は使い回させず別のタスクに再接続させる
+	s.httpServer.SetKeepAlivesEnabled(false)
+	select {
+	case <-time.After(s.opts.DrainDelay):
+	case <-s.opts.ForceStop:
+		logger.Warn("drain delay interrupted by forced stop")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.opts.ShutdownTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.opts.ForceStop:
+			cancel()
+		case <-shutdownCtx.Done():
+		}
+	}()
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		s.httpServer.Close()
+		return fmt.Errorf("failed to drain connections: %w", err)
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	logger.Info("shutdown completed")
+	return nil
+}
@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Serve(t *testing.T) {
+	tests := []struct {
+		name            string
+		handlerDelay    time.Duration
+		shutdownTimeout time.Duration
+		wantErr         bool
+		wantStatus      int
+	}{
+		{
+			name:            "正常系_処理中のリクエストを待って停止",
+			handlerDelay:    100 * time.Millisecond,
+			shutdownTimeout: time.Second,
+			wantStatus:      http.StatusOK,
+		},
+		{
+			name:            "異常系_処理中のリクエストが終わらない",
+			handlerDelay:    time.Second,
+			shutdownTimeout: 50 * time.Millisecond,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := make(chan struct{})
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(started)
+				time.Sleep(tt.handlerDelay)
+				w.Write([]byte("done"))
+			})
+			var drained atomic.Bool
+			srv := New(handler, Options{
+				ReadHeaderTimeout: time.Second,
+				MaxHeaderBytes:    1 << 10,
+				DrainDelay:        10 * time.Millisecond,
+				ShutdownTimeout:   tt.shutdownTimeout,
+				OnDrain:           func() { drained.Store(true) },
+			})
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			url := "http://" + listener.Addr().String()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- srv.Serve(ctx, listener)
+			}()
+
+			// 処理中のリクエストがある状態で停止する
+			type result struct {
+				status int
+				body   string
+				err    error
+			}
+			inFlight := make(chan result, 1)
+			go func() {
+				resp, err := http.Get(url)
+				if err != nil {
+					inFlight <- result{err: err}
+					return
+				}
+				defer resp.Body.Close()
+				body, _ := io.ReadAll(resp.Body)
+				inFlight <- result{status: resp.StatusCode, body: string(body)}
+			}()
+			<-started
+			cancel()
+
+			err = <-serveErr
+			assert.True(t, drained.Load())
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, (<-inFlight).err)
+				return
+			}
+
+			assert.NoError(t, err)
+			got := <-inFlight
+			assert.NoError(t, got.err)
+			assert.Equal(t, tt.wantStatus, got.status)
+			assert.Equal(t, "done", got.body)
+
+			// 停止後は新しい接続を受け付けない
+			_, err = http.Get(url)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestServer_Serve_ForceStop(t *testing.T) {
+	tests := []struct {
+		name     string
+		inFlight bool
+		wantErr  bool
+	}{
+		{
+			name:     "正常系_DrainDelayの待機を打ち切る",
+			inFlight: false,
+			wantErr:  false,
+		},
+		{
+			name:     "異常系_処理中のリクエストの待機を打ち切る",
+			inFlight: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			defer close(release)
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(started)
+				<-release
+			})
+			forceStop := make(chan struct{})
+			srv := New(handler, Options{
+				ReadHeaderTimeout: time.Second,
+				DrainDelay:        time.Minute,
+				ShutdownTimeout:   time.Minute,
+				ForceStop:         forceStop,
+			})
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			if tt.inFlight {
+				go http.Get("http://" + listener.Addr().String())
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- srv.Serve(ctx, listener)
+			}()
+			if tt.inFlight {
+				<-started
+			}
+			cancel()
+			close(forceStop)
+
+			select {
+			case err := <-serveErr:
+				if tt.wantErr {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Serve did not return after ForceStop was closed")
+			}
+		})
+	}
+}
+
+func TestServer_MaxHeaderBytes(t *testing.T) {
+	srv := New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), Options{
+		ReadHeaderTimeout: time.Second,
+		MaxHeaderBytes:    1 << 10,
+		ShutdownTimeout:   time.Second,
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.Serve(ctx, listener)
+
+	req, err := http.NewRequest(http.MethodGet, "http://"+listener.Addr().String(), nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Large", strings.Repeat("a", 8<<10))
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusRequestHeaderFieldsTooLarge, resp.StatusCode)
+}
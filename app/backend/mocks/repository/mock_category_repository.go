@@ -41,6 +41,48 @@ func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder
 	return m.recorder
 }
 
+// BatchPut mocks base method.
+func (m *MockICategoryRepository) BatchPut(ctx context.Context, categories []*model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchPut", ctx, categories)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchPut indicates an expected call of BatchPut.
+func (mr *MockICategoryRepositoryMockRecorder) BatchPut(ctx, categories any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPut", reflect.TypeOf((*MockICategoryRepository)(nil).BatchPut), ctx, categories)
+}
+
+// Create mocks base method.
+func (m *MockICategoryRepository) Create(ctx context.Context, category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICategoryRepositoryMockRecorder) Create(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICategoryRepository)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockICategoryRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockICategoryRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockICategoryRepository)(nil).Delete), ctx, id)
+}
+
 // GetCategoriesToData mocks base method.
 func (m *MockICategoryRepository) GetCategoriesToData(ctx context.Context) ([]*model.Category, error) {
 	m.ctrl.T.Helper()
@@ -55,3 +97,60 @@ func (mr *MockICategoryRepositoryMockRecorder) GetCategoriesToData(ctx any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesToData", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoriesToData), ctx)
 }
+
+// GetCategoryByIDToData mocks base method.
+func (m *MockICategoryRepository) GetCategoryByIDToData(ctx context.Context, id string) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByIDToData", ctx, id)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByIDToData indicates an expected call of GetCategoryByIDToData.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoryByIDToData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByIDToData", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoryByIDToData), ctx, id)
+}
+
+// MarkDeleting mocks base method.
+func (m *MockICategoryRepository) MarkDeleting(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleting", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleting indicates an expected call of MarkDeleting.
+func (mr *MockICategoryRepositoryMockRecorder) MarkDeleting(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleting", reflect.TypeOf((*MockICategoryRepository)(nil).MarkDeleting), ctx, id)
+}
+
+// UnmarkDeleting mocks base method.
+func (m *MockICategoryRepository) UnmarkDeleting(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmarkDeleting", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmarkDeleting indicates an expected call of UnmarkDeleting.
+func (mr *MockICategoryRepositoryMockRecorder) UnmarkDeleting(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmarkDeleting", reflect.TypeOf((*MockICategoryRepository)(nil).UnmarkDeleting), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockICategoryRepository) Update(ctx context.Context, category *model.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockICategoryRepositoryMockRecorder) Update(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockICategoryRepository)(nil).Update), ctx, category)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: progress_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=progress_repository.go -destination=../../mocks/repository/mock_progress_repository.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProgressRepository is a mock of IProgressRepository interface.
+type MockIProgressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProgressRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIProgressRepositoryMockRecorder is the mock recorder for MockIProgressRepository.
+type MockIProgressRepositoryMockRecorder struct {
+	mock *MockIProgressRepository
+}
+
+// NewMockIProgressRepository creates a new mock instance.
+func NewMockIProgressRepository(ctrl *gomock.Controller) *MockIProgressRepository {
+	mock := &MockIProgressRepository{ctrl: ctrl}
+	mock.recorder = &MockIProgressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProgressRepository) EXPECT() *MockIProgressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetQuizProgressToData mocks base method.
+func (m *MockIProgressRepository) GetQuizProgressToData(ctx context.Context, userID, category, quizID string) (*model.QuizProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuizProgressToData", ctx, userID, category, quizID)
+	ret0, _ := ret[0].(*model.QuizProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuizProgressToData indicates an expected call of GetQuizProgressToData.
+func (mr *MockIProgressRepositoryMockRecorder) GetQuizProgressToData(ctx, userID, category, quizID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizProgressToData", reflect.TypeOf((*MockIProgressRepository)(nil).GetQuizProgressToData), ctx, userID, category, quizID)
+}
+
+// GetQuizProgressesToData mocks base method.
+func (m *MockIProgressRepository) GetQuizProgressesToData(ctx context.Context, userID, category string) ([]*model.QuizProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuizProgressesToData", ctx, userID, category)
+	ret0, _ := ret[0].([]*model.QuizProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuizProgressesToData indicates an expected call of GetQuizProgressesToData.
+func (mr *MockIProgressRepositoryMockRecorder) GetQuizProgressesToData(ctx, userID, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizProgressesToData", reflect.TypeOf((*MockIProgressRepository)(nil).GetQuizProgressesToData), ctx, userID, category)
+}
+
+// SaveAnswer mocks base method.
+func (m *MockIProgressRepository) SaveAnswer(ctx context.Context, record *model.AnswerRecord, progress *model.QuizProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAnswer", ctx, record, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAnswer indicates an expected call of SaveAnswer.
+func (mr *MockIProgressRepositoryMockRecorder) SaveAnswer(ctx, record, progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAnswer", reflect.TypeOf((*MockIProgressRepository)(nil).SaveAnswer), ctx, record, progress)
+}
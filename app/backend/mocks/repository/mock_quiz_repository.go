@@ -41,6 +41,136 @@ func (m *MockIQuizRepository) EXPECT() *MockIQuizRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BatchDelete mocks base method.
+func (m *MockIQuizRepository) BatchDelete(ctx context.Context, quizzes []*model.Quiz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDelete", ctx, quizzes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDelete indicates an expected call of BatchDelete.
+func (mr *MockIQuizRepositoryMockRecorder) BatchDelete(ctx, quizzes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockIQuizRepository)(nil).BatchDelete), ctx, quizzes)
+}
+
+// BatchPut mocks base method.
+func (m *MockIQuizRepository) BatchPut(ctx context.Context, quizzes []*model.Quiz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchPut", ctx, quizzes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchPut indicates an expected call of BatchPut.
+func (mr *MockIQuizRepositoryMockRecorder) BatchPut(ctx, quizzes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPut", reflect.TypeOf((*MockIQuizRepository)(nil).BatchPut), ctx, quizzes)
+}
+
+// CountQuizzesByCategoryToData mocks base method.
+func (m *MockIQuizRepository) CountQuizzesByCategoryToData(ctx context.Context, category string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountQuizzesByCategoryToData", ctx, category)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountQuizzesByCategoryToData indicates an expected call of CountQuizzesByCategoryToData.
+func (mr *MockIQuizRepositoryMockRecorder) CountQuizzesByCategoryToData(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountQuizzesByCategoryToData", reflect.TypeOf((*MockIQuizRepository)(nil).CountQuizzesByCategoryToData), ctx, category)
+}
+
+// CountQuizzesPerCategoryToData mocks base method.
+func (m *MockIQuizRepository) CountQuizzesPerCategoryToData(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountQuizzesPerCategoryToData", ctx)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountQuizzesPerCategoryToData indicates an expected call of CountQuizzesPerCategoryToData.
+func (mr *MockIQuizRepositoryMockRecorder) CountQuizzesPerCategoryToData(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountQuizzesPerCategoryToData", reflect.TypeOf((*MockIQuizRepository)(nil).CountQuizzesPerCategoryToData), ctx)
+}
+
+// Create mocks base method.
+func (m *MockIQuizRepository) Create(ctx context.Context, quiz *model.Quiz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, quiz)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIQuizRepositoryMockRecorder) Create(ctx, quiz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIQuizRepository)(nil).Create), ctx, quiz)
+}
+
+// Delete mocks base method.
+func (m *MockIQuizRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIQuizRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIQuizRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteByCategory mocks base method.
+func (m *MockIQuizRepository) DeleteByCategory(ctx context.Context, category string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByCategory indicates an expected call of DeleteByCategory.
+func (mr *MockIQuizRepositoryMockRecorder) DeleteByCategory(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByCategory", reflect.TypeOf((*MockIQuizRepository)(nil).DeleteByCategory), ctx, category)
+}
+
+// GetAllQuizzesToData mocks base method.
+func (m *MockIQuizRepository) GetAllQuizzesToData(ctx context.Context) ([]*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllQuizzesToData", ctx)
+	ret0, _ := ret[0].([]*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllQuizzesToData indicates an expected call of GetAllQuizzesToData.
+func (mr *MockIQuizRepositoryMockRecorder) GetAllQuizzesToData(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllQuizzesToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetAllQuizzesToData), ctx)
+}
+
+// GetCorrectAnswersByCategoryToData mocks base method.
+func (m *MockIQuizRepository) GetCorrectAnswersByCategoryToData(ctx context.Context, category, lang string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorrectAnswersByCategoryToData", ctx, category, lang)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorrectAnswersByCategoryToData indicates an expected call of GetCorrectAnswersByCategoryToData.
+func (mr *MockIQuizRepositoryMockRecorder) GetCorrectAnswersByCategoryToData(ctx, category, lang any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorrectAnswersByCategoryToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetCorrectAnswersByCategoryToData), ctx, category, lang)
+}
+
 // GetQuizByIDToData mocks base method.
 func (m *MockIQuizRepository) GetQuizByIDToData(ctx context.Context, id string) (*model.Quiz, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +186,22 @@ func (mr *MockIQuizRepositoryMockRecorder) GetQuizByIDToData(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizByIDToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetQuizByIDToData), ctx, id)
 }
 
+// GetQuizPageByCategoryToData mocks base method.
+func (m *MockIQuizRepository) GetQuizPageByCategoryToData(ctx context.Context, category string, limit int, startKey map[string]string) ([]*model.Quiz, map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuizPageByCategoryToData", ctx, category, limit, startKey)
+	ret0, _ := ret[0].([]*model.Quiz)
+	ret1, _ := ret[1].(map[string]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetQuizPageByCategoryToData indicates an expected call of GetQuizPageByCategoryToData.
+func (mr *MockIQuizRepositoryMockRecorder) GetQuizPageByCategoryToData(ctx, category, limit, startKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizPageByCategoryToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetQuizPageByCategoryToData), ctx, category, limit, startKey)
+}
+
 // GetQuizzesByCategoryToData mocks base method.
 func (m *MockIQuizRepository) GetQuizzesByCategoryToData(ctx context.Context, category string, count int) ([]*model.Quiz, error) {
 	m.ctrl.T.Helper()
@@ -70,3 +216,32 @@ func (mr *MockIQuizRepositoryMockRecorder) GetQuizzesByCategoryToData(ctx, categ
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizzesByCategoryToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetQuizzesByCategoryToData), ctx, category, count)
 }
+
+// GetQuizzesByIDsToData mocks base method.
+func (m *MockIQuizRepository) GetQuizzesByIDsToData(ctx context.Context, category string, ids []string) ([]*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuizzesByIDsToData", ctx, category, ids)
+	ret0, _ := ret[0].([]*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuizzesByIDsToData indicates an expected call of GetQuizzesByIDsToData.
+func (mr *MockIQuizRepositoryMockRecorder) GetQuizzesByIDsToData(ctx, category, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizzesByIDsToData", reflect.TypeOf((*MockIQuizRepository)(nil).GetQuizzesByIDsToData), ctx, category, ids)
+}
+
+// Update mocks base method.
+func (m *MockIQuizRepository) Update(ctx context.Context, quiz *model.Quiz) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, quiz)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIQuizRepositoryMockRecorder) Update(ctx, quiz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIQuizRepository)(nil).Update), ctx, quiz)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session_repository.go -destination=../../mocks/repository/mock_session_repository.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockISessionRepository) CreateSession(ctx context.Context, session *model.QuizSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockISessionRepositoryMockRecorder) CreateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockISessionRepository)(nil).CreateSession), ctx, session)
+}
+
+// GetSessionByIDToData mocks base method.
+func (m *MockISessionRepository) GetSessionByIDToData(ctx context.Context, id string) (*model.QuizSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByIDToData", ctx, id)
+	ret0, _ := ret[0].(*model.QuizSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionByIDToData indicates an expected call of GetSessionByIDToData.
+func (mr *MockISessionRepositoryMockRecorder) GetSessionByIDToData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByIDToData", reflect.TypeOf((*MockISessionRepository)(nil).GetSessionByIDToData), ctx, id)
+}
+
+// UpdateSession mocks base method.
+func (m *MockISessionRepository) UpdateSession(ctx context.Context, session *model.QuizSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *MockISessionRepositoryMockRecorder) UpdateSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockISessionRepository)(nil).UpdateSession), ctx, session)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_repository.go -destination=../../mocks/repository/mock_user_repository.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUserRepository is a mock of IUserRepository interface.
+type MockIUserRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUserRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIUserRepositoryMockRecorder is the mock recorder for MockIUserRepository.
+type MockIUserRepositoryMockRecorder struct {
+	mock *MockIUserRepository
+}
+
+// NewMockIUserRepository creates a new mock instance.
+func NewMockIUserRepository(ctrl *gomock.Controller) *MockIUserRepository {
+	mock := &MockIUserRepository{ctrl: ctrl}
+	mock.recorder = &MockIUserRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUserRepository) EXPECT() *MockIUserRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockIUserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockIUserRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIUserRepository)(nil).CreateUser), ctx, user)
+}
+
+// GetUserByEmailToData mocks base method.
+func (m *MockIUserRepository) GetUserByEmailToData(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmailToData", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmailToData indicates an expected call of GetUserByEmailToData.
+func (mr *MockIUserRepositoryMockRecorder) GetUserByEmailToData(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmailToData", reflect.TypeOf((*MockIUserRepository)(nil).GetUserByEmailToData), ctx, email)
+}
+
+// GetUserByIDToData mocks base method.
+func (m *MockIUserRepository) GetUserByIDToData(ctx context.Context, id string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByIDToData", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByIDToData indicates an expected call of GetUserByIDToData.
+func (mr *MockIUserRepositoryMockRecorder) GetUserByIDToData(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByIDToData", reflect.TypeOf((*MockIUserRepository)(nil).GetUserByIDToData), ctx, id)
+}
+
+// UpdateUser mocks base method.
+func (m *MockIUserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockIUserRepositoryMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserRepository)(nil).UpdateUser), ctx, user)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health_checker.go
+//
+// Generated by this command:
+//
+//	mockgen -source=health_checker.go -destination=../../mocks/service/mock_health_checker.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHealthChecker is a mock of IHealthChecker interface.
+type MockIHealthChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockIHealthCheckerMockRecorder is the mock recorder for MockIHealthChecker.
+type MockIHealthCheckerMockRecorder struct {
+	mock *MockIHealthChecker
+}
+
+// NewMockIHealthChecker creates a new mock instance.
+func NewMockIHealthChecker(ctrl *gomock.Controller) *MockIHealthChecker {
+	mock := &MockIHealthChecker{ctrl: ctrl}
+	mock.recorder = &MockIHealthCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthChecker) EXPECT() *MockIHealthCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockIHealthChecker) Check(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockIHealthCheckerMockRecorder) Check(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockIHealthChecker)(nil).Check), ctx)
+}
+
+// Name mocks base method.
+func (m *MockIHealthChecker) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockIHealthCheckerMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockIHealthChecker)(nil).Name))
+}
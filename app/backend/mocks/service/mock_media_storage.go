@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_storage.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media_storage.go -destination=../../mocks/service/mock_media_storage.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMediaStorage is a mock of IMediaStorage interface.
+type MockIMediaStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMediaStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockIMediaStorageMockRecorder is the mock recorder for MockIMediaStorage.
+type MockIMediaStorageMockRecorder struct {
+	mock *MockIMediaStorage
+}
+
+// NewMockIMediaStorage creates a new mock instance.
+func NewMockIMediaStorage(ctrl *gomock.Controller) *MockIMediaStorage {
+	mock := &MockIMediaStorage{ctrl: ctrl}
+	mock.recorder = &MockIMediaStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMediaStorage) EXPECT() *MockIMediaStorageMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockIMediaStorage) Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, body, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockIMediaStorageMockRecorder) Put(ctx, key, body, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIMediaStorage)(nil).Put), ctx, key, body, contentType)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=token_service.go -destination=../../mocks/service/mock_token_service.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	model "audio-slide-app/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITokenService is a mock of ITokenService interface.
+type MockITokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITokenServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockITokenServiceMockRecorder is the mock recorder for MockITokenService.
+type MockITokenServiceMockRecorder struct {
+	mock *MockITokenService
+}
+
+// NewMockITokenService creates a new mock instance.
+func NewMockITokenService(ctrl *gomock.Controller) *MockITokenService {
+	mock := &MockITokenService{ctrl: ctrl}
+	mock.recorder = &MockITokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITokenService) EXPECT() *MockITokenServiceMockRecorder {
+	return m.recorder
+}
+
+// IssueTokens mocks base method.
+func (m *MockITokenService) IssueTokens(user *model.User) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokens", user)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueTokens indicates an expected call of IssueTokens.
+func (mr *MockITokenServiceMockRecorder) IssueTokens(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokens", reflect.TypeOf((*MockITokenService)(nil).IssueTokens), user)
+}
+
+// ParseToken mocks base method.
+func (m *MockITokenService) ParseToken(token, tokenType string) (*model.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token, tokenType)
+	ret0, _ := ret[0].(*model.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockITokenServiceMockRecorder) ParseToken(token, tokenType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockITokenService)(nil).ParseToken), token, tokenType)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=auth_usecase.go -destination=../../mocks/usecase/mock_auth_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	authctx "audio-slide-app/common/authctx"
+	dto "audio-slide-app/domain/dto"
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAuthUseCase is a mock of IAuthUseCase interface.
+type MockIAuthUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuthUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIAuthUseCaseMockRecorder is the mock recorder for MockIAuthUseCase.
+type MockIAuthUseCaseMockRecorder struct {
+	mock *MockIAuthUseCase
+}
+
+// NewMockIAuthUseCase creates a new mock instance.
+func NewMockIAuthUseCase(ctrl *gomock.Controller) *MockIAuthUseCase {
+	mock := &MockIAuthUseCase{ctrl: ctrl}
+	mock.recorder = &MockIAuthUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuthUseCase) EXPECT() *MockIAuthUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIAuthUseCase) Authenticate(ctx context.Context, accessToken string) (*authctx.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, accessToken)
+	ret0, _ := ret[0].(*authctx.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIAuthUseCaseMockRecorder) Authenticate(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIAuthUseCase)(nil).Authenticate), ctx, accessToken)
+}
+
+// GetCurrentUser mocks base method.
+func (m *MockIAuthUseCase) GetCurrentUser(ctx context.Context) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *MockIAuthUseCaseMockRecorder) GetCurrentUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*MockIAuthUseCase)(nil).GetCurrentUser), ctx)
+}
+
+// Login mocks base method.
+func (m *MockIAuthUseCase) Login(ctx context.Context, req *dto.LoginRequest) (*model.AuthResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, req)
+	ret0, _ := ret[0].(*model.AuthResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockIAuthUseCaseMockRecorder) Login(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthUseCase)(nil).Login), ctx, req)
+}
+
+// Refresh mocks base method.
+func (m *MockIAuthUseCase) Refresh(ctx context.Context, refreshToken string) (*model.AuthResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*model.AuthResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIAuthUseCaseMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIAuthUseCase)(nil).Refresh), ctx, refreshToken)
+}
+
+// SignUp mocks base method.
+func (m *MockIAuthUseCase) SignUp(ctx context.Context, req *dto.SignUpRequest) (*model.AuthResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignUp", ctx, req)
+	ret0, _ := ret[0].(*model.AuthResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignUp indicates an expected call of SignUp.
+func (mr *MockIAuthUseCaseMockRecorder) SignUp(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockIAuthUseCase)(nil).SignUp), ctx, req)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: category_admin_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=category_admin_usecase.go -destination=../../mocks/usecase/mock_category_admin_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICategoryAdminUseCase is a mock of ICategoryAdminUseCase interface.
+type MockICategoryAdminUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICategoryAdminUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockICategoryAdminUseCaseMockRecorder is the mock recorder for MockICategoryAdminUseCase.
+type MockICategoryAdminUseCaseMockRecorder struct {
+	mock *MockICategoryAdminUseCase
+}
+
+// NewMockICategoryAdminUseCase creates a new mock instance.
+func NewMockICategoryAdminUseCase(ctrl *gomock.Controller) *MockICategoryAdminUseCase {
+	mock := &MockICategoryAdminUseCase{ctrl: ctrl}
+	mock.recorder = &MockICategoryAdminUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICategoryAdminUseCase) EXPECT() *MockICategoryAdminUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockICategoryAdminUseCase) CreateCategory(ctx context.Context, req *dto.CategoryRequest) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, req)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICategoryAdminUseCaseMockRecorder) CreateCategory(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryAdminUseCase)(nil).CreateCategory), ctx, req)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICategoryAdminUseCase) DeleteCategory(ctx context.Context, id string, cascade bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id, cascade)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICategoryAdminUseCaseMockRecorder) DeleteCategory(ctx, id, cascade any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryAdminUseCase)(nil).DeleteCategory), ctx, id, cascade)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICategoryAdminUseCase) UpdateCategory(ctx context.Context, id string, req *dto.CategoryRequest) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, id, req)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICategoryAdminUseCaseMockRecorder) UpdateCategory(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICategoryAdminUseCase)(nil).UpdateCategory), ctx, id, req)
+}
@@ -55,3 +55,18 @@ func (mr *MockICategoryUseCaseMockRecorder) GetCategories(ctx any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockICategoryUseCase)(nil).GetCategories), ctx)
 }
+
+// GetCategoryQuizzes mocks base method.
+func (m *MockICategoryUseCase) GetCategoryQuizzes(ctx context.Context, categoryID, pageCursor string, limit int) (*model.QuizPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryQuizzes", ctx, categoryID, pageCursor, limit)
+	ret0, _ := ret[0].(*model.QuizPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryQuizzes indicates an expected call of GetCategoryQuizzes.
+func (mr *MockICategoryUseCaseMockRecorder) GetCategoryQuizzes(ctx, categoryID, pageCursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryQuizzes", reflect.TypeOf((*MockICategoryUseCase)(nil).GetCategoryQuizzes), ctx, categoryID, pageCursor, limit)
+}
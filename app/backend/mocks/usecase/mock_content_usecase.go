@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_usecase.go -destination=../../mocks/usecase/mock_content_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIContentUseCase is a mock of IContentUseCase interface.
+type MockIContentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIContentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIContentUseCaseMockRecorder is the mock recorder for MockIContentUseCase.
+type MockIContentUseCaseMockRecorder struct {
+	mock *MockIContentUseCase
+}
+
+// NewMockIContentUseCase creates a new mock instance.
+func NewMockIContentUseCase(ctrl *gomock.Controller) *MockIContentUseCase {
+	mock := &MockIContentUseCase{ctrl: ctrl}
+	mock.recorder = &MockIContentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIContentUseCase) EXPECT() *MockIContentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockIContentUseCase) Export(ctx context.Context) (*model.ContentSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx)
+	ret0, _ := ret[0].(*model.ContentSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockIContentUseCaseMockRecorder) Export(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockIContentUseCase)(nil).Export), ctx)
+}
+
+// Import mocks base method.
+func (m *MockIContentUseCase) Import(ctx context.Context, content *model.ContentSet, opts dto.ImportOptions) (*model.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, content, opts)
+	ret0, _ := ret[0].(*model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockIContentUseCaseMockRecorder) Import(ctx, content, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockIContentUseCase)(nil).Import), ctx, content, opts)
+}
+
+// Lint mocks base method.
+func (m *MockIContentUseCase) Lint(ctx context.Context) (*model.LintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lint", ctx)
+	ret0, _ := ret[0].(*model.LintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lint indicates an expected call of Lint.
+func (mr *MockIContentUseCaseMockRecorder) Lint(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lint", reflect.TypeOf((*MockIContentUseCase)(nil).Lint), ctx)
+}
+
+// Reindex mocks base method.
+func (m *MockIContentUseCase) Reindex(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockIContentUseCaseMockRecorder) Reindex(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockIContentUseCase)(nil).Reindex), ctx)
+}
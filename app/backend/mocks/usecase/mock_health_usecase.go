@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=health_usecase.go -destination=../../mocks/usecase/mock_health_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHealthUseCase is a mock of IHealthUseCase interface.
+type MockIHealthUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIHealthUseCaseMockRecorder is the mock recorder for MockIHealthUseCase.
+type MockIHealthUseCaseMockRecorder struct {
+	mock *MockIHealthUseCase
+}
+
+// NewMockIHealthUseCase creates a new mock instance.
+func NewMockIHealthUseCase(ctrl *gomock.Controller) *MockIHealthUseCase {
+	mock := &MockIHealthUseCase{ctrl: ctrl}
+	mock.recorder = &MockIHealthUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthUseCase) EXPECT() *MockIHealthUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Live mocks base method.
+func (m *MockIHealthUseCase) Live(ctx context.Context) *dto.HealthResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Live", ctx)
+	ret0, _ := ret[0].(*dto.HealthResponse)
+	return ret0
+}
+
+// Live indicates an expected call of Live.
+func (mr *MockIHealthUseCaseMockRecorder) Live(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Live", reflect.TypeOf((*MockIHealthUseCase)(nil).Live), ctx)
+}
+
+// Ready mocks base method.
+func (m *MockIHealthUseCase) Ready(ctx context.Context) *dto.HealthResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(*dto.HealthResponse)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockIHealthUseCaseMockRecorder) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockIHealthUseCase)(nil).Ready), ctx)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=media_usecase.go -destination=../../mocks/usecase/mock_media_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMediaUseCase is a mock of IMediaUseCase interface.
+type MockIMediaUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMediaUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIMediaUseCaseMockRecorder is the mock recorder for MockIMediaUseCase.
+type MockIMediaUseCaseMockRecorder struct {
+	mock *MockIMediaUseCase
+}
+
+// NewMockIMediaUseCase creates a new mock instance.
+func NewMockIMediaUseCase(ctrl *gomock.Controller) *MockIMediaUseCase {
+	mock := &MockIMediaUseCase{ctrl: ctrl}
+	mock.recorder = &MockIMediaUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMediaUseCase) EXPECT() *MockIMediaUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UploadMedia mocks base method.
+func (m *MockIMediaUseCase) UploadMedia(ctx context.Context, req *dto.MediaUploadRequest) (*dto.MediaUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMedia", ctx, req)
+	ret0, _ := ret[0].(*dto.MediaUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadMedia indicates an expected call of UploadMedia.
+func (mr *MockIMediaUseCaseMockRecorder) UploadMedia(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMedia", reflect.TypeOf((*MockIMediaUseCase)(nil).UploadMedia), ctx, req)
+}
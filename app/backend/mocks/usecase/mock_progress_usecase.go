@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: progress_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=progress_usecase.go -destination=../../mocks/usecase/mock_progress_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProgressUseCase is a mock of IProgressUseCase interface.
+type MockIProgressUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProgressUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIProgressUseCaseMockRecorder is the mock recorder for MockIProgressUseCase.
+type MockIProgressUseCaseMockRecorder struct {
+	mock *MockIProgressUseCase
+}
+
+// NewMockIProgressUseCase creates a new mock instance.
+func NewMockIProgressUseCase(ctrl *gomock.Controller) *MockIProgressUseCase {
+	mock := &MockIProgressUseCase{ctrl: ctrl}
+	mock.recorder = &MockIProgressUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProgressUseCase) EXPECT() *MockIProgressUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetCategoryProgress mocks base method.
+func (m *MockIProgressUseCase) GetCategoryProgress(ctx context.Context, category string) (*model.CategoryProgressDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryProgress", ctx, category)
+	ret0, _ := ret[0].(*model.CategoryProgressDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryProgress indicates an expected call of GetCategoryProgress.
+func (mr *MockIProgressUseCaseMockRecorder) GetCategoryProgress(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryProgress", reflect.TypeOf((*MockIProgressUseCase)(nil).GetCategoryProgress), ctx, category)
+}
+
+// GetProgress mocks base method.
+func (m *MockIProgressUseCase) GetProgress(ctx context.Context) ([]*model.CategoryProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress", ctx)
+	ret0, _ := ret[0].([]*model.CategoryProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockIProgressUseCaseMockRecorder) GetProgress(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockIProgressUseCase)(nil).GetProgress), ctx)
+}
+
+// GetReviewQueue mocks base method.
+func (m *MockIProgressUseCase) GetReviewQueue(ctx context.Context, category string) (*model.ReviewQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewQueue", ctx, category)
+	ret0, _ := ret[0].(*model.ReviewQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewQueue indicates an expected call of GetReviewQueue.
+func (mr *MockIProgressUseCaseMockRecorder) GetReviewQueue(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewQueue", reflect.TypeOf((*MockIProgressUseCase)(nil).GetReviewQueue), ctx, category)
+}
+
+// RecordAnswer mocks base method.
+func (m *MockIProgressUseCase) RecordAnswer(ctx context.Context, result *model.AnswerResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAnswer", ctx, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAnswer indicates an expected call of RecordAnswer.
+func (mr *MockIProgressUseCaseMockRecorder) RecordAnswer(ctx, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAnswer", reflect.TypeOf((*MockIProgressUseCase)(nil).RecordAnswer), ctx, result)
+}
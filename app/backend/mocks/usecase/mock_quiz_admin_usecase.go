@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: quiz_admin_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=quiz_admin_usecase.go -destination=../../mocks/usecase/mock_quiz_admin_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIQuizAdminUseCase is a mock of IQuizAdminUseCase interface.
+type MockIQuizAdminUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuizAdminUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIQuizAdminUseCaseMockRecorder is the mock recorder for MockIQuizAdminUseCase.
+type MockIQuizAdminUseCaseMockRecorder struct {
+	mock *MockIQuizAdminUseCase
+}
+
+// NewMockIQuizAdminUseCase creates a new mock instance.
+func NewMockIQuizAdminUseCase(ctrl *gomock.Controller) *MockIQuizAdminUseCase {
+	mock := &MockIQuizAdminUseCase{ctrl: ctrl}
+	mock.recorder = &MockIQuizAdminUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuizAdminUseCase) EXPECT() *MockIQuizAdminUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateQuiz mocks base method.
+func (m *MockIQuizAdminUseCase) CreateQuiz(ctx context.Context, req *dto.QuizRequest) (*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuiz", ctx, req)
+	ret0, _ := ret[0].(*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuiz indicates an expected call of CreateQuiz.
+func (mr *MockIQuizAdminUseCaseMockRecorder) CreateQuiz(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuiz", reflect.TypeOf((*MockIQuizAdminUseCase)(nil).CreateQuiz), ctx, req)
+}
+
+// DeleteQuiz mocks base method.
+func (m *MockIQuizAdminUseCase) DeleteQuiz(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuiz", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuiz indicates an expected call of DeleteQuiz.
+func (mr *MockIQuizAdminUseCaseMockRecorder) DeleteQuiz(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuiz", reflect.TypeOf((*MockIQuizAdminUseCase)(nil).DeleteQuiz), ctx, id)
+}
+
+// PatchQuiz mocks base method.
+func (m *MockIQuizAdminUseCase) PatchQuiz(ctx context.Context, id string, req *dto.PatchQuizRequest) (*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchQuiz", ctx, id, req)
+	ret0, _ := ret[0].(*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchQuiz indicates an expected call of PatchQuiz.
+func (mr *MockIQuizAdminUseCaseMockRecorder) PatchQuiz(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchQuiz", reflect.TypeOf((*MockIQuizAdminUseCase)(nil).PatchQuiz), ctx, id, req)
+}
+
+// UpdateQuiz mocks base method.
+func (m *MockIQuizAdminUseCase) UpdateQuiz(ctx context.Context, id string, req *dto.QuizRequest) (*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuiz", ctx, id, req)
+	ret0, _ := ret[0].(*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuiz indicates an expected call of UpdateQuiz.
+func (mr *MockIQuizAdminUseCaseMockRecorder) UpdateQuiz(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuiz", reflect.TypeOf((*MockIQuizAdminUseCase)(nil).UpdateQuiz), ctx, id, req)
+}
@@ -42,31 +42,76 @@ func (m *MockIQuizUseCase) EXPECT() *MockIQuizUseCaseMockRecorder {
 }
 
 // GetQuizByID mocks base method.
-func (m *MockIQuizUseCase) GetQuizByID(ctx context.Context, id string) (*model.Quiz, error) {
+func (m *MockIQuizUseCase) GetQuizByID(ctx context.Context, id string, choiceCount int) (*model.Quiz, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQuizByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetQuizByID", ctx, id, choiceCount)
 	ret0, _ := ret[0].(*model.Quiz)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetQuizByID indicates an expected call of GetQuizByID.
-func (mr *MockIQuizUseCaseMockRecorder) GetQuizByID(ctx, id any) *gomock.Call {
+func (mr *MockIQuizUseCaseMockRecorder) GetQuizByID(ctx, id, choiceCount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizByID", reflect.TypeOf((*MockIQuizUseCase)(nil).GetQuizByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizByID", reflect.TypeOf((*MockIQuizUseCase)(nil).GetQuizByID), ctx, id, choiceCount)
 }
 
 // GetQuizzesByCategory mocks base method.
-func (m *MockIQuizUseCase) GetQuizzesByCategory(ctx context.Context, category string, count int) ([]*model.Quiz, error) {
+func (m *MockIQuizUseCase) GetQuizzesByCategory(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQuizzesByCategory", ctx, category, count)
+	ret := m.ctrl.Call(m, "GetQuizzesByCategory", ctx, category, count, choiceCount)
 	ret0, _ := ret[0].([]*model.Quiz)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetQuizzesByCategory indicates an expected call of GetQuizzesByCategory.
-func (mr *MockIQuizUseCaseMockRecorder) GetQuizzesByCategory(ctx, category, count any) *gomock.Call {
+func (mr *MockIQuizUseCaseMockRecorder) GetQuizzesByCategory(ctx, category, count, choiceCount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizzesByCategory", reflect.TypeOf((*MockIQuizUseCase)(nil).GetQuizzesByCategory), ctx, category, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuizzesByCategory", reflect.TypeOf((*MockIQuizUseCase)(nil).GetQuizzesByCategory), ctx, category, count, choiceCount)
+}
+
+// GetReviewQuizzes mocks base method.
+func (m *MockIQuizUseCase) GetReviewQuizzes(ctx context.Context, category string, count, choiceCount int) ([]*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewQuizzes", ctx, category, count, choiceCount)
+	ret0, _ := ret[0].([]*model.Quiz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewQuizzes indicates an expected call of GetReviewQuizzes.
+func (mr *MockIQuizUseCaseMockRecorder) GetReviewQuizzes(ctx, category, count, choiceCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewQuizzes", reflect.TypeOf((*MockIQuizUseCase)(nil).GetReviewQuizzes), ctx, category, count, choiceCount)
+}
+
+// GradeAnswer mocks base method.
+func (m *MockIQuizUseCase) GradeAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GradeAnswer", ctx, id, answer)
+	ret0, _ := ret[0].(*model.AnswerResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GradeAnswer indicates an expected call of GradeAnswer.
+func (mr *MockIQuizUseCaseMockRecorder) GradeAnswer(ctx, id, answer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GradeAnswer", reflect.TypeOf((*MockIQuizUseCase)(nil).GradeAnswer), ctx, id, answer)
+}
+
+// SubmitAnswer mocks base method.
+func (m *MockIQuizUseCase) SubmitAnswer(ctx context.Context, id, answer string) (*model.AnswerResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitAnswer", ctx, id, answer)
+	ret0, _ := ret[0].(*model.AnswerResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAnswer indicates an expected call of SubmitAnswer.
+func (mr *MockIQuizUseCaseMockRecorder) SubmitAnswer(ctx, id, answer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAnswer", reflect.TypeOf((*MockIQuizUseCase)(nil).SubmitAnswer), ctx, id, answer)
 }
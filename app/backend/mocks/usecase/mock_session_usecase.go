@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session_usecase.go -destination=../../mocks/usecase/mock_session_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISessionUseCase is a mock of ISessionUseCase interface.
+type MockISessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockISessionUseCaseMockRecorder is the mock recorder for MockISessionUseCase.
+type MockISessionUseCaseMockRecorder struct {
+	mock *MockISessionUseCase
+}
+
+// NewMockISessionUseCase creates a new mock instance.
+func NewMockISessionUseCase(ctrl *gomock.Controller) *MockISessionUseCase {
+	mock := &MockISessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockISessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionUseCase) EXPECT() *MockISessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateSession mocks base method.
+func (m *MockISessionUseCase) CreateSession(ctx context.Context, category string, count, choiceCount int) (*model.QuizSession, []*model.Quiz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, category, count, choiceCount)
+	ret0, _ := ret[0].(*model.QuizSession)
+	ret1, _ := ret[1].([]*model.Quiz)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockISessionUseCaseMockRecorder) CreateSession(ctx, category, count, choiceCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockISessionUseCase)(nil).CreateSession), ctx, category, count, choiceCount)
+}
+
+// GetSessionResult mocks base method.
+func (m *MockISessionUseCase) GetSessionResult(ctx context.Context, sessionID string) (*model.SessionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionResult", ctx, sessionID)
+	ret0, _ := ret[0].(*model.SessionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionResult indicates an expected call of GetSessionResult.
+func (mr *MockISessionUseCaseMockRecorder) GetSessionResult(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionResult", reflect.TypeOf((*MockISessionUseCase)(nil).GetSessionResult), ctx, sessionID)
+}
+
+// SubmitSessionAnswer mocks base method.
+func (m *MockISessionUseCase) SubmitSessionAnswer(ctx context.Context, sessionID, quizID, answer string) (*model.AnswerResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSessionAnswer", ctx, sessionID, quizID, answer)
+	ret0, _ := ret[0].(*model.AnswerResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSessionAnswer indicates an expected call of SubmitSessionAnswer.
+func (mr *MockISessionUseCaseMockRecorder) SubmitSessionAnswer(ctx, sessionID, quizID, answer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSessionAnswer", reflect.TypeOf((*MockISessionUseCase)(nil).SubmitSessionAnswer), ctx, sessionID, quizID, answer)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_admin_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_admin_usecase.go -destination=../../mocks/usecase/mock_user_admin_usecase.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	dto "audio-slide-app/domain/dto"
+	model "audio-slide-app/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUserAdminUseCase is a mock of IUserAdminUseCase interface.
+type MockIUserAdminUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUserAdminUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIUserAdminUseCaseMockRecorder is the mock recorder for MockIUserAdminUseCase.
+type MockIUserAdminUseCaseMockRecorder struct {
+	mock *MockIUserAdminUseCase
+}
+
+// NewMockIUserAdminUseCase creates a new mock instance.
+func NewMockIUserAdminUseCase(ctrl *gomock.Controller) *MockIUserAdminUseCase {
+	mock := &MockIUserAdminUseCase{ctrl: ctrl}
+	mock.recorder = &MockIUserAdminUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUserAdminUseCase) EXPECT() *MockIUserAdminUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockIUserAdminUseCase) UpdateUserRole(ctx context.Context, id string, req *dto.UpdateUserRoleRequest) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, id, req)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockIUserAdminUseCaseMockRecorder) UpdateUserRole(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockIUserAdminUseCase)(nil).UpdateUserRole), ctx, id, req)
+}
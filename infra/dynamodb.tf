@@ -42,6 +42,16 @@ resource "aws_dynamodb_table" "quiz" {
     type = "S"   # String型
   }
 
+  attribute {
+    name = "entityType"  # エンティティ種別（CATEGORY等、一覧取得が必要なアイテムのみ保持）
+    type = "S"           # String型
+  }
+
+  attribute {
+    name = "randomKey"  # ランダム出題用のソートキー（16桁の16進数、クイズアイテムのみ保持）
+    type = "S"          # String型
+  }
+
   # ==================================================
   # グローバルセカンダリインデックス（GSI）
   # ==================================================
@@ -56,6 +66,42 @@ resource "aws_dynamodb_table" "quiz" {
     projection_type = "ALL"              # 全属性をインデックスに投影（パフォーマンス優先）
   }
 
+  # エンティティ種別ごとの一覧取得用インデックス（スパースインデックス）
+  # entityType を持つアイテム（カテゴリ情報 CATEGORY#<id>/META 等）のみが載る
+  global_secondary_index {
+    name            = "entityType-index"  # GSI名
+    hash_key        = "entityType"        # GSIのパーティションキー
+    range_key       = "PK"                # GSIのソートキー
+    projection_type = "ALL"               # 全属性をインデックスに投影
+  }
+
+  # クイズID単体での検索用インデックス
+  # カテゴリが不明な状態でも1回のクエリでクイズを特定できる
+  global_secondary_index {
+    name            = "id-index"  # GSI名
+    hash_key        = "id"        # GSIのパーティションキー
+    projection_type = "ALL"       # 全属性をインデックスに投影
+  }
+
+  # ランダム出題用インデックス
+  # ランダムな開始位置から randomKey 順に必要な件数だけを読み取り、カテゴリ全件の読み込みを避ける
+  global_secondary_index {
+    name            = "category-random-index"  # GSI名
+    hash_key        = "category"               # GSIのパーティションキー
+    range_key       = "randomKey"              # GSIのソートキー
+    projection_type = "ALL"                    # 全属性をインデックスに投影
+  }
+
+  # ==================================================
+  # TTL設定
+  # ==================================================
+  # クイズセッション（SESSION#）は expiresAt を過ぎると自動削除される
+
+  ttl {
+    attribute_name = "expiresAt"  # UNIX時間（秒）で有効期限を保持
+    enabled        = true
+  }
+
   tags = {
     Name = "${var.project_name}-quiz-table"
   }
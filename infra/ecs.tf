@@ -48,6 +48,8 @@ resource "aws_ecs_task_definition" "backend" {
     {
       name  = "${var.project_name}-backend"
       image = "${aws_ecr_repository.backend.repository_url}:latest"  # GitHub Actionsでコミットハッシュに更新される
+      # SIGTERM から強制終了までの猶予（SHUTDOWN_DRAIN_DELAY・SHUTDOWN_TIMEOUT・TRACING_SHUTDOWN_TIMEOUT の合計より長くする）
+      stopTimeout = 30
       portMappings = [
         {
           containerPort = 8080
@@ -70,6 +72,14 @@ resource "aws_ecs_task_definition" "backend" {
         {
           name  = "S3_REGION"
           value = var.aws_region
+        },
+        {
+          name  = "MEDIA_SIGNER"
+          value = "s3"
+        },
+        {
+          name  = "MEDIA_URL_TTL"
+          value = "15m"
         }
       ]
       logConfiguration = {
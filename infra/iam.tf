@@ -42,7 +42,7 @@ resource "aws_iam_role_policy_attachment" "ecs_task_execution_role_policy" {
 # ==================================================
 # アプリケーションコードが実行時に使用するロール
 # - DynamoDBへのアクセス権限
-# - S3へのアクセス権限（必要に応じて）
+# - S3へのアクセス権限（メディアの署名付き URL の発行とアップロード）
 # - その他のAWSサービスへのアクセス権限
 
 resource "aws_iam_role" "ecs_task_role" {
@@ -81,7 +81,9 @@ resource "aws_iam_policy" "dynamodb_policy" {
           "dynamodb:UpdateItem", # アイテムの更新
           "dynamodb:DeleteItem", # アイテムの削除
           "dynamodb:BatchGetItem",    # バッチ取得
-          "dynamodb:BatchWriteItem"   # バッチ書き込み
+          "dynamodb:BatchWriteItem",  # バッチ書き込み
+          "dynamodb:ConditionCheckItem", # トランザクション内の存在確認
+          "dynamodb:DescribeTable"    # ヘルスチェック（/api/health/ready）でのテーブル確認
         ]
         # アクセス対象リソースを指定
         Resource = [
@@ -100,4 +102,46 @@ resource "aws_iam_policy" "dynamodb_policy" {
 resource "aws_iam_role_policy_attachment" "ecs_task_role_dynamodb_policy" {
   role       = aws_iam_role.ecs_task_role.name
   policy_arn = aws_iam_policy.dynamodb_policy.arn
-}
\ No newline at end of file
+}
+# ==================================================
+# S3アクセスポリシー
+# ==================================================
+# バケットは非公開のため、バックエンドが署名付き URL を発行してメディアを配信する
+# 署名付き URL は発行したロールの権限で評価されるため、Task Role にオブジェクトの読み取り権限が必要
+# 管理 API（POST /api/admin/media）でアップロードしたファイルの書き込みにも使う
+
+resource "aws_iam_policy" "s3_assets_policy" {
+  name        = "${var.project_name}-S3AssetsPolicy"
+  description = "S3 access for media URLs and uploads of Audio Slide App"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:GetObject", # 署名付き URL でのオブジェクトの読み取り
+          "s3:PutObject"  # 管理 API からの画像・音声のアップロード
+        ]
+        Resource = [
+          "${aws_s3_bucket.assets.arn}/*"
+        ]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:ListBucket" # ヘルスチェック（/api/health/ready）での HeadBucket
+        ]
+        Resource = [
+          aws_s3_bucket.assets.arn
+        ]
+      }
+    ]
+  })
+}
+
+# S3ポリシーをECS Task Roleにアタッチ
+resource "aws_iam_role_policy_attachment" "ecs_task_role_s3_assets_policy" {
+  role       = aws_iam_role.ecs_task_role.name
+  policy_arn = aws_iam_policy.s3_assets_policy.arn
+}
@@ -67,13 +67,18 @@ resource "aws_lb_target_group" "backend" {
   vpc_id      = aws_vpc.main.id                   # 専用VPCを指定
   target_type = "ip"                              # IPアドレスベースのターゲット（Fargate用）
 
+  # 登録解除の遅延（処理中のリクエストを待つ時間）
+  # ECS はタスクを停止するとき、先にターゲットグループから登録解除し、この時間が経過してから SIGTERM を送る
+  # そのため SIGTERM を受け取った時点で ALB は新しいリクエストを転送していない（ヘルスチェックの失敗を待つ必要はない）
+  deregistration_delay = 30
+
   # APIサーバー用ヘルスチェック設定
   health_check {
     enabled             = true                  # ヘルスチェック有効化
     healthy_threshold   = 2                     # 正常判定闾値（連続2回成功で正常）
     interval            = 30                    # チェック間隔（30秒）
     matcher             = "200"                 # 正常レスポンスコード
-    path                = "/api/health"         # APIヘルスチェックエンドポイント
+    path                = "/api/health/ready"   # 依存先（DynamoDB・S3・設定）まで確認するエンドポイント
     port                = "traffic-port"        # トラフィックポートと同じポートでチェック
     protocol            = "HTTP"                # チェックプロトコル
     timeout             = 5                     # タイムアウト（5秒）
@@ -28,8 +28,8 @@ output "application_url" {
 
 # APIヘルスチェックURL
 output "health_check_url" {
-  description = "APIヘルスチェック用URL（サーバー状態確認用）"
-  value       = "http://${aws_lb.main.dns_name}/api/health"
+  description = "APIヘルスチェック用URL（依存先を含めたサーバー状態確認用）"
+  value       = "http://${aws_lb.main.dns_name}/api/health/ready"
 }
 
 # ==================================================
@@ -59,7 +59,7 @@ output "s3_bucket_name" {
 
 # S3バケットURL
 output "s3_bucket_url" {
-  description = "静的アセット用S3バケットURL（ブラウザアクセス用）"
+  description = "静的アセット用S3バケットURL（非公開のため、ブラウザからは API が返す署名付き URL でアクセス）"
   value       = "https://${aws_s3_bucket.assets.bucket}.s3.${var.aws_region}.amazonaws.com"
 }
 
@@ -4,7 +4,7 @@
 # 静的アセット（画像・音声ファイル）を保存するS3バケットを作成
 # - 国旗、動物、単語の画像ファイル
 # - 各カテゴリの音声ファイル（MP3）
-# - パブリック読み取りアクセスを許可
+# - パブリックアクセスはすべてブロックし、API が発行する署名付き URL でのみ配信する
 
 # ==================================================
 # メインS3バケット
@@ -48,38 +48,18 @@ resource "aws_s3_bucket_server_side_encryption_configuration" "assets" {
 # ==================================================
 # S3パブリックアクセス設定
 # ==================================================
-# 静的アセットをWebアプリケーションから直接アクセスできるように設定
+# オブジェクトは公開せず、バックエンドが発行する期限付きの署名付き URL でアクセスさせる
+# （DynamoDB にはオブジェクトキーのみを保存し、レスポンスごとに署名する）
 
 # S3バケットのパブリックアクセスブロック設定
-# パブリック読み取りアクセスを許可するため、ブロックを無効化
+# パブリックACL・パブリックポリシーをすべてブロック
 resource "aws_s3_bucket_public_access_block" "assets" {
   bucket = aws_s3_bucket.assets.id
 
-  block_public_acls       = false  # パブリックACLを許可
-  block_public_policy     = false  # パブリックポリシーを許可
-  ignore_public_acls      = false  # パブリックACLを無視しない
-  restrict_public_buckets = false  # パブリックバケットを制限しない
-}
-
-# S3バケットポリシー（パブリック読み取りアクセス用）
-# 全てのユーザーがオブジェクトを読み取り可能にする
-resource "aws_s3_bucket_policy" "assets" {
-  bucket = aws_s3_bucket.assets.id
-  depends_on = [aws_s3_bucket_public_access_block.assets]  # パブリックアクセスブロック設定後に実行
-
-  # パブリック読み取りアクセスを許可するポリシー
-  policy = jsonencode({
-    Version = "2012-10-17"
-    Statement = [
-      {
-        Sid       = "PublicReadGetObject"               # ステートメントID
-        Effect    = "Allow"                           # アクセスを許可
-        Principal = "*"                               # 全てのユーザー
-        Action    = "s3:GetObject"                     # オブジェクトの読み取り権限
-        Resource  = "${aws_s3_bucket.assets.arn}/*"   # バケット内の全オブジェクト
-      }
-    ]
-  })
+  block_public_acls       = true  # パブリックACLをブロック
+  block_public_policy     = true  # パブリックポリシーをブロック
+  ignore_public_acls      = true  # 既存のパブリックACLを無視
+  restrict_public_buckets = true  # パブリックバケットへのアクセスを制限
 }
 
 # ==================================================